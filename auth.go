@@ -0,0 +1,72 @@
+package reqparse
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+)
+
+// Authorization is the parsed value of the Authorization header.
+type Authorization struct {
+	// Scheme is the authentication scheme as sent by the client, e.g. "Bearer" or "Basic". Use
+	// [strings.EqualFold] for comparing schemes, they are case-insensitive.
+	Scheme string
+
+	// Token is the credentials after the scheme, e.g. the bearer token. It is empty if the header
+	// contains only the scheme.
+	Token string
+
+	// Username and Password are the decoded credentials of the Basic scheme. They are empty for the
+	// other schemes.
+	Username string
+	Password string
+}
+
+// ParseAuthorization parses the Authorization header of the request. If the header is missing,
+// [ErrAuthorizationNotFound] is returned. If the header is malformed, e.g. the credentials of the
+// Basic scheme are not valid base64 encoded "username:password", [ErrInvalidAuthorization] is
+// returned.
+func ParseAuthorization(r *http.Request) (Authorization, error) {
+	value := r.Header.Get("Authorization")
+	if value == "" {
+		return Authorization{}, ErrAuthorizationNotFound
+	}
+
+	scheme, token, _ := strings.Cut(strings.TrimSpace(value), " ")
+	auth := Authorization{
+		Scheme: scheme,
+		Token:  strings.TrimSpace(token),
+	}
+
+	if !strings.EqualFold(auth.Scheme, "Basic") {
+		return auth, nil
+	}
+
+	credentials, err := base64.StdEncoding.DecodeString(auth.Token)
+	if err != nil {
+		return Authorization{}, ErrInvalidAuthorization
+	}
+
+	username, password, ok := strings.Cut(string(credentials), ":")
+	if !ok {
+		return Authorization{}, ErrInvalidAuthorization
+	}
+
+	auth.Username = username
+	auth.Password = password
+
+	return auth, nil
+}
+
+// trimAuthScheme returns the credentials of the Authorization header value if its scheme is the
+// given scheme. The scheme is compared case-insensitively.
+func trimAuthScheme(value string, scheme string) (string, bool) {
+	valueScheme, token, _ := strings.Cut(strings.TrimSpace(value), " ")
+	token = strings.TrimSpace(token)
+
+	if !strings.EqualFold(valueScheme, scheme) || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
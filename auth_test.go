@@ -0,0 +1,148 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuthorization(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		header   string
+		expected reqparse.Authorization
+		err      error
+	}{
+		{
+			name:     "bearer",
+			header:   "Bearer abc.def.ghi",
+			expected: reqparse.Authorization{Scheme: "Bearer", Token: "abc.def.ghi"},
+		},
+		{
+			name:   "basic",
+			header: "basic dXNlcjpwYXNzOndvcmQ=",
+			expected: reqparse.Authorization{
+				Scheme:   "basic",
+				Token:    "dXNlcjpwYXNzOndvcmQ=",
+				Username: "user",
+				Password: "pass:word",
+			},
+		},
+		{
+			name:   "custom scheme",
+			header: `Digest username="berk", realm="api"`,
+			expected: reqparse.Authorization{
+				Scheme: "Digest",
+				Token:  `username="berk", realm="api"`,
+			},
+		},
+		{
+			name:     "scheme only",
+			header:   "Negotiate",
+			expected: reqparse.Authorization{Scheme: "Negotiate"},
+		},
+		{name: "missing", header: "", err: reqparse.ErrAuthorizationNotFound},
+		{name: "basic invalid base64", header: "Basic !!!", err: reqparse.ErrInvalidAuthorization},
+		{
+			name:   "basic without colon",
+			header: "Basic dXNlcg==",
+			err:    reqparse.ErrInvalidAuthorization,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.header != "" {
+				r.Header.Set("Authorization", tc.header)
+			}
+
+			auth, err := reqparse.ParseAuthorization(r)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, auth)
+		})
+	}
+}
+
+func TestAuthSchemeTag(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Token  string  `header:"Authorization" authscheme:"Bearer"`
+		APIKey *string `header:"X-Api-Key"     authscheme:"ApiKey"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "bearer abc.def")
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Token: "abc.def"}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+		r.Header.Set("X-Api-Key", "ApiKey")
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"Authorization": {"must be a valid Bearer authorization"},
+			"X-Api-Key":     {"must be a valid ApiKey authorization"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"Authorization": {"field is required"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			Token string `header:"Authorization" authscheme:""`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseRequest(httptest.NewRequest(http.MethodGet, "/", nil), &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
@@ -0,0 +1,40 @@
+package reqparse
+
+import (
+	"math/big"
+	"reflect"
+)
+
+// bigFloatPrecision is the mantissa precision in bits of the big.Float values casted from the
+// source values.
+const bigFloatPrecision = 256
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})   //nolint:gochecknoglobals
+	bigFloatType = reflect.TypeOf(big.Float{}) //nolint:gochecknoglobals
+)
+
+// castBigValue casts the value into a big.Int or big.Float value. ok is false if the target type is
+// none of them. Like the other numeric types, values are parsed in base 10; infinities are invalid.
+func castBigValue(targetType reflect.Type, value string) (reflect.Value, bool, error) {
+	switch targetType {
+	case bigIntType:
+		i, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return reflect.Value{}, true, errInvalidInteger
+		}
+
+		return reflect.ValueOf(i).Elem(), true, nil
+
+	case bigFloatType:
+		f, _, err := new(big.Float).SetPrec(bigFloatPrecision).Parse(value, 10)
+		if err != nil || f.IsInf() {
+			return reflect.Value{}, true, errInvalidFloat
+		}
+
+		return reflect.ValueOf(f).Elem(), true, nil
+
+	default:
+		return reflect.Value{}, false, nil
+	}
+}
@@ -0,0 +1,79 @@
+package reqparse_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryBigNumbers(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Amount  *big.Int   `query:"amount"`
+		Balance big.Int    `query:"balance"  default:"0"`
+		Amounts []big.Int  `query:"amounts"`
+		Price   *big.Float `query:"price"    stripsuffix:"USD"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"amount":  {"123456789012345678901234567890"},
+			"amounts": {"-1", "18446744073709551616"},
+			"price":   {"0.1000000000000000000001USD"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		require.NotNil(t, s.Amount)
+		assert.Equal(t, "123456789012345678901234567890", s.Amount.String())
+		assert.Equal(t, "0", s.Balance.String())
+		require.Len(t, s.Amounts, 2)
+		assert.Equal(t, "-1", s.Amounts[0].String())
+		assert.Equal(t, "18446744073709551616", s.Amounts[1].String())
+		require.NotNil(t, s.Price)
+		assert.Equal(t, "0.1000000000000000000001", s.Price.Text('f', 22))
+	})
+
+	t.Run("optional fields", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, s.Amount)
+		assert.Nil(t, s.Price)
+		assert.Empty(t, s.Amounts)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"amount":  {"0x10"},
+			"balance": {"1.5"},
+			"amounts": {"1", "abc"},
+			"price":   {"Inf"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"amount":  {"must be a valid integer"},
+			"balance": {"must be a valid integer"},
+			"amounts": {"(Index: 1) must be a valid integer"},
+			"price":   {"must be a valid float"},
+		}, validationErr.FieldErrors)
+	})
+}
@@ -0,0 +1,123 @@
+package reqparse
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// BodyParser parses a request body of a media type into the target struct. [ParseJSON],
+// [ParseXML] and [ParseYAML] are body parsers.
+type BodyParser func(body io.Reader, target any, opts *ParseQueryOptions) error
+
+// formSource is the source of the url encoded form bodies.
+var formSource = bindingSource{ //nolint:gochecknoglobals
+	source:         SourceBody,
+	tagName:        "form",
+	errTagNotFound: ErrFormTagNotFound,
+	description:    "form",
+}
+
+// ParseBody parses the request body into given struct with the parser of the media type in the
+// Content-Type header:
+//
+//   - application/json and media types with +json suffix: [ParseJSON].
+//   - application/xml, text/xml and media types with +xml suffix: [ParseXML].
+//   - application/x-www-form-urlencoded: fields are bound from the form values by their `form`
+//     tags, the same way as the non-file fields of [ParseMultipart]. Malformed forms are reported
+//     with a [QueryValidationError], while the errors reading the body and the error of the forms
+//     larger than 10 MB are returned as is.
+//   - multipart/form-data: [ParseMultipart].
+//
+// Parsers of additional media types can be registered with [ParseQueryOptions.BodyParsers], which
+// take precedence over the built-in ones. If the Content-Type header is missing or its media type
+// has no parser, an error wrapping [ErrUnsupportedMediaType] is returned.
+// If options are nil, default options are used.
+func ParseBody(r *http.Request, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseBody(r, target)
+}
+
+// parseBody is the implementation of [Parser.ParseBody]. opts must be non-nil.
+func parseBody(r *http.Request, target any, opts *ParseQueryOptions) error {
+	contentType := r.Header.Get("Content-Type")
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrUnsupportedMediaType, contentType)
+	}
+
+	if parser, ok := opts.BodyParsers[mediaType]; ok {
+		return parser(r.Body, target, opts)
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return parseJSON(r.Body, target, opts)
+	case mediaType == "application/xml" || mediaType == "text/xml" ||
+		strings.HasSuffix(mediaType, "+xml"):
+		return parseXML(r.Body, target, opts)
+	case mediaType == "application/x-www-form-urlencoded":
+		return parseForm(r, target, opts)
+	case mediaType == "multipart/form-data":
+		return parseMultipart(r, target, opts)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedMediaType, mediaType)
+	}
+}
+
+// parseForm binds the url encoded form body of the request into the target struct. opts must be
+// non-nil.
+func parseForm(r *http.Request, target any, opts *ParseQueryOptions) error {
+	if err := parseFormBody(r); err != nil {
+		if !errors.Is(err, errMalformedForm) {
+			return err
+		}
+
+		validationErrors := newQueryValidationError(SourceBody, formSource.description, opts)
+		validationErrors.addStructError("request body must be a valid form")
+
+		return validationErrors.err()
+	}
+
+	return parseValues(r.PostForm, target, formSource, opts)
+}
+
+// maxFormSize is the size limit of the url encoded form bodies read by [http.Request.ParseForm].
+const maxFormSize = 10 << 20
+
+// errMalformedForm is returned by [parseFormBody] if the request body is not a valid url encoded
+// form.
+var errMalformedForm = errors.New("malformed form")
+
+// parseFormBody calls [http.Request.ParseForm]. Errors of malformed forms, e.g. invalid
+// percent-encoding, are replaced with [errMalformedForm], while the errors reading the body, e.g.
+// the error of [http.MaxBytesReader], and the error of the bodies larger than 10 MB are returned as
+// is.
+func parseFormBody(r *http.Request) error {
+	body := r.Body
+	if body == nil {
+		body = http.NoBody
+	}
+
+	recordingBody := &errorRecordingBody{ReadCloser: body}
+	originalBody := r.Body
+	r.Body = recordingBody
+
+	err := r.ParseForm()
+
+	r.Body = originalBody
+
+	switch {
+	case err == nil:
+		return nil
+	case recordingBody.err != nil:
+		return recordingBody.err
+	case recordingBody.bytesRead > maxFormSize:
+		return err
+	default:
+		return errMalformedForm
+	}
+}
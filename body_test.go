@@ -0,0 +1,174 @@
+package reqparse_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBody(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Name string `json:"name" xml:"name" yaml:"name" form:"name"`
+		Age  int    `json:"age"  xml:"age"  yaml:"age"  form:"age"`
+	}
+
+	newRequest := func(contentType string, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+
+		return r
+	}
+
+	t.Run("built-in media types", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			contentType string
+			body        string
+		}{
+			{contentType: "application/json", body: `{"name": "Berk", "age": 25}`},
+			{contentType: "application/json; charset=utf-8", body: `{"name": "Berk", "age": 25}`},
+			{contentType: "application/vnd.api+json", body: `{"name": "Berk", "age": 25}`},
+			{contentType: "application/xml", body: `<user><name>Berk</name><age>25</age></user>`},
+			{contentType: "Text/XML", body: `<user><name>Berk</name><age>25</age></user>`},
+			{contentType: "application/x-www-form-urlencoded", body: "name=Berk&age=25"},
+		}
+
+		for _, tc := range testCases {
+			var s MyStruct
+			err := reqparse.ParseBody(newRequest(tc.contentType, tc.body), &s, nil)
+
+			require.NoError(t, err, tc.contentType)
+			assert.Equal(t, MyStruct{Name: "Berk", Age: 25}, s, tc.contentType)
+		}
+	})
+
+	t.Run("multipart form", func(t *testing.T) {
+		t.Parallel()
+
+		r := newMultipartRequest(t, map[string][]string{"name": {"Berk"}, "age": {"25"}}, nil)
+
+		var s MyStruct
+		err := reqparse.ParseBody(r, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: "Berk", Age: 25}, s)
+	})
+
+	t.Run("form validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseBody(
+			newRequest("application/x-www-form-urlencoded", "age=old"), &s, nil,
+		)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name": {"field is required"},
+			"age":  {"must be a valid integer"},
+		}, validationError.FieldErrors)
+		assert.Contains(t, validationError.Error(), "Parsing form failed.")
+	})
+
+	t.Run("invalid form", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseBody(
+			newRequest("application/x-www-form-urlencoded", "name=%zz"), &s, nil,
+		)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, []string{"request body must be a valid form"}, validationError.StructErrors)
+	})
+
+	t.Run("form read and size errors", func(t *testing.T) {
+		t.Parallel()
+
+		errRead := errors.New("connection reset")
+		r := newRequest("application/x-www-form-urlencoded", "")
+		r.Body = io.NopCloser(io.MultiReader(
+			strings.NewReader("name=Berk&"), iotest.ErrReader(errRead),
+		))
+
+		var s MyStruct
+		err := reqparse.ParseBody(r, &s, nil)
+
+		require.ErrorIs(t, err, errRead)
+
+		w := httptest.NewRecorder()
+		r = newRequest("application/x-www-form-urlencoded", "name=Berk&age=25")
+		r.Body = http.MaxBytesReader(w, r.Body, 5)
+
+		err = reqparse.ParseBody(r, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.Error(t, err)
+		assert.False(t, errors.As(err, &validationError))
+
+		// Url encoded forms are limited to 10 MB by http.Request.ParseForm.
+		r = newRequest("application/x-www-form-urlencoded", "name="+strings.Repeat("a", 10<<20))
+
+		err = reqparse.ParseBody(r, &s, nil)
+
+		require.Error(t, err)
+		assert.False(t, errors.As(err, &validationError))
+	})
+
+	t.Run("registered media type", func(t *testing.T) {
+		t.Parallel()
+
+		var called bool
+
+		opts := &reqparse.ParseQueryOptions{
+			BodyParsers: map[string]reqparse.BodyParser{
+				"application/yaml": reqparse.ParseYAML,
+				"application/json": func(
+					body io.Reader, target any, opts *reqparse.ParseQueryOptions,
+				) error {
+					called = true
+					return reqparse.ParseJSON(body, target, opts)
+				},
+			},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseBody(newRequest("application/yaml", "name: Berk\nage: 25"), &s, opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: "Berk", Age: 25}, s)
+
+		err = reqparse.ParseBody(
+			newRequest("application/json", `{"name": "Berk", "age": 25}`), &s, opts,
+		)
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("unsupported media type", func(t *testing.T) {
+		t.Parallel()
+
+		for _, contentType := range []string{"", "text/plain", "application/yaml", "invalid;;"} {
+			var s MyStruct
+			err := reqparse.ParseBody(newRequest(contentType, "name: Berk"), &s, nil)
+
+			require.ErrorIs(t, err, reqparse.ErrUnsupportedMediaType, contentType)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// BoolMode selects the values accepted by bool fields.
+type BoolMode string
+
+const (
+	// BoolModeDefault accepts the values accepted by [strconv.ParseBool]. It is the default mode.
+	BoolModeDefault BoolMode = "default"
+
+	// BoolModeLenient accepts "yes", "y" and "on" as true, and "no", "n" and "off" as false, in
+	// addition to the values accepted by [strconv.ParseBool]. Matching is case-insensitive. It is
+	// useful for HTML checkboxes, which submit "on" when checked.
+	BoolModeLenient BoolMode = "lenient"
+
+	// BoolModeStrict accepts only "true" and "false". Matching is case-sensitive. It is useful for
+	// APIs whose contracts demand exact literal booleans.
+	BoolModeStrict BoolMode = "strict"
+)
+
+// lenientTrueTokens and lenientFalseTokens are the lower case words accepted as bool values by
+// [BoolModeLenient].
+//
+//nolint:gochecknoglobals
+var (
+	lenientTrueTokens  = []string{"1", "t", "true", "y", "yes", "on"}
+	lenientFalseTokens = []string{"0", "f", "false", "n", "no", "off"}
+)
+
+// isValidBoolMode reports whether the mode is one of the defined modes or empty.
+func isValidBoolMode(mode BoolMode) bool {
+	switch mode {
+	case "", BoolModeDefault, BoolModeLenient, BoolModeStrict:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkBoolMode returns an error if the BoolMode option is invalid.
+func checkBoolMode(opts *ParseQueryOptions) error {
+	if !isValidBoolMode(opts.BoolMode) {
+		return fmt.Errorf("%w: BoolMode %q", ErrInvalidOption, opts.BoolMode)
+	}
+
+	return nil
+}
+
+// setBoolMode sets the bool tokens of the cast options for the mode selected by the `boolmode` tag
+// of the field or the BoolMode option. The tag can't be used together with the `booltokens` tag.
+func (o *castOptions) setBoolMode(structField reflect.StructField, mode BoolMode) error {
+	if tag, ok := structField.Tag.Lookup("boolmode"); ok {
+		_, hasTokens := structField.Tag.Lookup("booltokens")
+		if tag == "" || !isValidBoolMode(BoolMode(tag)) || hasTokens {
+			return fmt.Errorf("%w: boolmode:%q (%s)", ErrInvalidTag, tag, structField.Name)
+		}
+
+		mode = BoolMode(tag)
+	} else if o.trueTokens != nil {
+		// Bool tokens of the field take precedence over the option.
+		return nil
+	}
+
+	switch mode { //nolint:exhaustive
+	case BoolModeLenient:
+		o.trueTokens = lenientTrueTokens
+		o.falseTokens = lenientFalseTokens
+	case BoolModeStrict:
+		o.strictBools = true
+	}
+
+	return nil
+}
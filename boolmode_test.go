@@ -0,0 +1,209 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryLenientBools(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Subscribe bool   `query:"subscribe" boolmode:"lenient"`
+		Remember  *bool  `query:"remember"  boolmode:"lenient"`
+		Flags     []bool `query:"flag"      boolmode:"lenient"`
+		Strict    bool   `query:"strict"    boolmode:"default"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"subscribe": {"on"},
+			"remember":  {"No"},
+			"flag":      {"YES", "off", "y", "N", "1", "False"},
+			"strict":    {"T"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		remember := false
+		assert.Equal(t, MyStruct{
+			Subscribe: true,
+			Remember:  &remember,
+			Flags:     []bool{true, false, true, false, true, false},
+			Strict:    true,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"subscribe": {"maybe"},
+			"remember":  {""},
+			"flag":      {"on", "enabled"},
+			"strict":    {"yes"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"subscribe": {"must be a valid boolean"},
+			"remember":  {"must be a valid boolean"},
+			"flag":      {"(Index: 1) must be a valid boolean"},
+			"strict":    {"must be a valid boolean"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("option", func(t *testing.T) {
+		t.Parallel()
+
+		type OptionStruct struct {
+			Subscribe bool `query:"subscribe"`
+			Tokens    bool `query:"tokens"    booltokens:"enabled:disabled"`
+			Default   bool `query:"default"   boolmode:"default"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"subscribe": {"on"},
+			"tokens":    {"enabled"},
+			"default":   {"on"},
+		}
+
+		var s OptionStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{BoolMode: reqparse.BoolModeLenient},
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"default": {"must be a valid boolean"},
+		}, validationErr.FieldErrors)
+
+		inputQueryParams["default"] = []string{"true"}
+
+		err = reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{BoolMode: reqparse.BoolModeLenient},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, OptionStruct{Subscribe: true, Tokens: true, Default: true}, s)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{},
+			&s,
+			&reqparse.ParseQueryOptions{BoolMode: "loose"},
+		)
+		require.ErrorIs(t, err, reqparse.ErrInvalidOption)
+
+		type UnknownMode struct {
+			Subscribe bool `query:"subscribe" boolmode:"loose"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &UnknownMode{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type ModeAndTokens struct {
+			Subscribe bool `query:"subscribe" boolmode:"lenient" booltokens:"on:off"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &ModeAndTokens{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
+
+func TestParseQueryStrictBools(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Active   bool   `query:"active"`
+		Flags    []bool `query:"flag"`
+		Lenient  bool   `query:"lenient"  boolmode:"lenient"`
+		Verified *bool  `query:"verified" boolmode:"strict"`
+	}
+
+	strictOpts := &reqparse.ParseQueryOptions{BoolMode: reqparse.BoolModeStrict}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"active":   {"true"},
+			"flag":     {"false", "true"},
+			"lenient":  {"on"},
+			"verified": {"false"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, strictOpts)
+		require.NoError(t, err)
+
+		verified := false
+		assert.Equal(t, MyStruct{
+			Active:   true,
+			Flags:    []bool{false, true},
+			Lenient:  true,
+			Verified: &verified,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"active":   {"1"},
+			"flag":     {"t", "TRUE", "False"},
+			"lenient":  {"yes"},
+			"verified": {"True"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, strictOpts)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"active": {"must be a valid boolean"},
+			"flag": {
+				"(Index: 0) must be a valid boolean",
+				"(Index: 1) must be a valid boolean",
+				"(Index: 2) must be a valid boolean",
+			},
+			"verified": {"must be a valid boolean"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("presence bools", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"active": {""}, "lenient": {"off"}},
+			&s,
+			&reqparse.ParseQueryOptions{BoolMode: reqparse.BoolModeStrict, PresenceBools: true},
+		)
+		require.NoError(t, err)
+
+		assert.True(t, s.Active)
+		assert.Nil(t, s.Verified)
+	})
+}
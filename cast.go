@@ -0,0 +1,568 @@
+package reqparse
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Errors returned by castQueryValue. Their messages are reported as validation errors.
+//
+//nolint:gochecknoglobals
+var (
+	errInvalidInteger  = newCodedError(CodeInvalidInteger, "must be a valid integer", nil)
+	errInvalidUnsigned = newCodedError(
+		CodeInvalidUnsignedInteger, "must be a valid unsigned integer", nil,
+	)
+	errInvalidFloat   = newCodedError(CodeInvalidFloat, "must be a valid float", nil)
+	errInvalidBoolean = newCodedError(CodeInvalidBoolean, "must be a valid boolean", nil)
+	errInvalidDate    = newCodedError(CodeInvalidDate, "must be a valid date", nil)
+	errInvalidChars   = newCodedError(
+		CodeInvalidCharacters, "contains invalid characters", nil,
+	)
+	errInvalidDuration = newCodedError(CodeInvalidDuration, "must be a valid duration", nil)
+	errInvalidValue    = newCodedError(CodeInvalidValue, "must be a valid value", nil)
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})      //nolint:gochecknoglobals
+	durationType = reflect.TypeOf(time.Duration(0)) //nolint:gochecknoglobals
+)
+
+//nolint:gochecknoglobals
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// durationUnits are the units accepted by the `durationunit` tag and their names used in the
+// validation error messages.
+var durationUnits = map[string]struct { //nolint:gochecknoglobals
+	duration time.Duration
+	name     string
+}{
+	"ns": {time.Nanosecond, "nanoseconds"},
+	"us": {time.Microsecond, "microseconds"},
+	"µs": {time.Microsecond, "microseconds"},
+	"ms": {time.Millisecond, "milliseconds"},
+	"s":  {time.Second, "seconds"},
+	"m":  {time.Minute, "minutes"},
+	"h":  {time.Hour, "hours"},
+}
+
+// timeFormats are the named formats accepted by the `timeformat` tag and their layouts.
+var timeFormats = map[string]string{ //nolint:gochecknoglobals
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+	"rfc1123":     time.RFC1123,
+	"date":        "2006-01-02",
+	"datetime":    "2006-01-02 15:04:05",
+}
+
+// unixTimeFormats are the named formats of the `timeformat` tag for Unix timestamps and their
+// units.
+var unixTimeFormats = map[string]time.Duration{ //nolint:gochecknoglobals
+	"unix":      time.Second,
+	"unixmilli": time.Millisecond,
+	"unixmicro": time.Microsecond,
+	"unixnano":  time.Nanosecond,
+}
+
+// castOptions holds the field specific settings used while casting query values. They are read
+// from the struct tags of the field.
+type castOptions struct {
+	// timeLayouts are the layouts tried in order for parsing time.Time values.
+	timeLayouts []string
+
+	// unixTimeUnit is the unit of the Unix timestamps parsed as time.Time values. If it is not
+	// zero, timeLayouts are not used.
+	unixTimeUnit time.Duration
+
+	// invalidTimeErr is the error returned for invalid time.Time values. It mentions the time
+	// format if it is set with the `timeformat` tag.
+	invalidTimeErr error
+
+	// emptyBoolIsTrue makes empty bool values casted as true. See
+	// [ParseQueryOptions.PresenceBools].
+	emptyBoolIsTrue bool
+
+	// negateBool inverts the casted bool values.
+	negateBool bool
+
+	// trueTokens and falseTokens are the lower case words accepted as bool values. If they are nil,
+	// bool values are parsed with [strconv.ParseBool].
+	trueTokens  []string
+	falseTokens []string
+
+	// strictBools makes only "true" and "false" valid bool values. See [BoolModeStrict].
+	strictBools bool
+
+	// durationUnit is the unit of the bare integer duration values. If it is zero, only duration
+	// strings accepted by [time.ParseDuration] are valid.
+	durationUnit time.Duration
+
+	// invalidDurationErr is the error returned for invalid duration values. It mentions the
+	// duration unit if it is set.
+	invalidDurationErr error
+
+	// authScheme is the authentication scheme that string values must start with, e.g. "Bearer".
+	// The scheme is trimmed from the casted values. See the `authscheme` tag.
+	authScheme string
+
+	// invalidAuthErr is the error returned for values without the authentication scheme.
+	invalidAuthErr error
+
+	// rejectControlChars makes string values containing control or format characters invalid. See
+	// [ParseQueryOptions.RejectControlChars].
+	rejectControlChars bool
+
+	// decimalSep and groupSep are the decimal and group separators of the float values. They are
+	// empty if the values are parsed with [strconv.ParseFloat] as is. See [normalizeFloat].
+	decimalSep string
+	groupSep   string
+
+	// intBase is the base of the integer values selected by the `base` tag. It is zero for base 10
+	// and [baseFromPrefix] for base 0. See [intBaseFromTag].
+	intBase int
+
+	// urlKind is the kind of URLs accepted by URL fields. It is empty if both absolute and relative
+	// URLs are accepted. See the `url` tag.
+	urlKind string
+
+	// converters are the converters of [ParseQueryOptions.Converters]. Registered converters are
+	// looked up while casting.
+	converters []Converter
+}
+
+// newCastOptions reads the cast options from the struct tags of a field.
+func newCastOptions( //nolint:funlen
+	structField reflect.StructField,
+	parseOpts *ParseQueryOptions,
+) (castOptions, error) {
+	opts := castOptions{
+		timeLayouts:        []string{time.RFC3339},
+		emptyBoolIsTrue:    parseOpts.PresenceBools,
+		negateBool:         structField.Tag.Get("negate") == "true",
+		rejectControlChars: parseOpts.RejectControlChars,
+		converters:         parseOpts.Converters,
+		invalidDurationErr: errInvalidDuration,
+		invalidTimeErr:     errInvalidDate,
+	}
+
+	if unitName, ok := structField.Tag.Lookup("durationunit"); ok {
+		unit, ok := durationUnits[unitName]
+		if !ok {
+			return opts, fmt.Errorf(
+				"%w: durationunit:%q (%s)", ErrInvalidTag, unitName, structField.Name,
+			)
+		}
+
+		opts.durationUnit = unit.duration
+		opts.invalidDurationErr = newCodedError(
+			CodeInvalidDuration,
+			"must be a valid duration or number of "+unit.name,
+			map[string]any{"unit": unit.name},
+		)
+	}
+
+	if authScheme, ok := structField.Tag.Lookup("authscheme"); ok {
+		if authScheme == "" || strings.ContainsAny(authScheme, " \t") {
+			return opts, fmt.Errorf(
+				"%w: authscheme:%q (%s)", ErrInvalidTag, authScheme, structField.Name,
+			)
+		}
+
+		opts.authScheme = authScheme
+		opts.invalidAuthErr = newCodedError(
+			CodeInvalidAuthorization,
+			"must be a valid "+authScheme+" authorization",
+			map[string]any{"scheme": authScheme},
+		)
+	}
+
+	if layout, ok := structField.Tag.Lookup("layout"); ok {
+		opts.timeLayouts = strings.Split(layout, "|")
+	}
+
+	if timeFormat, ok := structField.Tag.Lookup("timeformat"); ok {
+		if _, hasLayout := structField.Tag.Lookup("layout"); hasLayout || timeFormat == "" {
+			return opts, fmt.Errorf(
+				"%w: timeformat:%q (%s)", ErrInvalidTag, timeFormat, structField.Name,
+			)
+		}
+
+		opts.timeLayouts = []string{timeFormat}
+		if layout, ok := timeFormats[strings.ToLower(timeFormat)]; ok {
+			opts.timeLayouts = []string{layout}
+		}
+
+		opts.unixTimeUnit = unixTimeFormats[strings.ToLower(timeFormat)]
+		opts.invalidTimeErr = newCodedError(
+			CodeInvalidDate,
+			"must be a valid date/time in format "+timeFormat,
+			map[string]any{"format": timeFormat},
+		)
+	}
+
+	urlKind, err := urlKindFromTag(structField)
+	if err != nil {
+		return opts, err
+	}
+
+	opts.urlKind = urlKind
+
+	intBase, err := intBaseFromTag(structField, parseOpts.Converters)
+	if err != nil {
+		return opts, err
+	}
+
+	opts.intBase = intBase
+
+	err = opts.setNumberSeparators(structField, parseOpts.DecimalSeparator, parseOpts.Converters)
+	if err != nil {
+		return opts, err
+	}
+
+	if boolTokens, ok := structField.Tag.Lookup("booltokens"); ok {
+		trueTokens, falseTokens, found := strings.Cut(strings.ToLower(boolTokens), ":")
+		if !found || trueTokens == "" || falseTokens == "" {
+			return opts, fmt.Errorf(
+				"%w: booltokens:%q (%s)", ErrInvalidTag, boolTokens, structField.Name,
+			)
+		}
+
+		opts.trueTokens = strings.Split(trueTokens, ",")
+		opts.falseTokens = strings.Split(falseTokens, ",")
+	}
+
+	if err := opts.setBoolMode(structField, parseOpts.BoolMode); err != nil {
+		return opts, err
+	}
+
+	return opts, nil
+}
+
+// castQueryValue casts the query value to a value of the given type. The type must be one of the
+// non-slice, non-pointer types allowed for query parsing. If the value can't be casted, the
+// returned error's message is meant to be reported as a validation error. The error is a
+// [castError] holding the value and the type.
+func castQueryValue(
+	targetType reflect.Type,
+	value string,
+	opts castOptions,
+) (reflect.Value, error) {
+	castedValue, err := castValue(targetType, value, opts)
+	if err == nil {
+		return castedValue, nil
+	}
+
+	// Values of the nullable types are casted into their value fields, whose errors are kept.
+	var castErr *castError
+	if errors.As(err, &castErr) {
+		return castedValue, err
+	}
+
+	return castedValue, &castError{err: err, value: value, targetType: targetType}
+}
+
+// castValue is the implementation of [castQueryValue] without the [castError] wrapping.
+func castValue( //nolint:cyclop
+	targetType reflect.Type,
+	value string,
+	opts castOptions,
+) (reflect.Value, error) {
+	castedValue := reflect.New(targetType).Elem()
+
+	if converter, ok := lookupConverter(targetType, opts.converters); ok {
+		v, err := converter.convert(value)
+		if err != nil {
+			return castedValue, err
+		}
+
+		return v, nil
+	}
+
+	if targetType == timeType {
+		t, err := parseTime(value, opts)
+		if err != nil {
+			return castedValue, opts.invalidTimeErr
+		}
+
+		castedValue.Set(reflect.ValueOf(t))
+
+		return castedValue, nil
+	}
+
+	if targetType == durationType {
+		d, err := parseDuration(value, opts.durationUnit)
+		if err != nil {
+			return castedValue, opts.invalidDurationErr
+		}
+
+		castedValue.SetInt(int64(d))
+
+		return castedValue, nil
+	}
+
+	if targetType == rangeHeaderType {
+		h, err := ParseRangeHeader(value)
+		if err != nil {
+			return castedValue, errInvalidRange
+		}
+
+		castedValue.Set(reflect.ValueOf(h))
+
+		return castedValue, nil
+	}
+
+	if targetType == acceptLanguageType {
+		a, err := ParseAcceptLanguage(value)
+		if err != nil {
+			return castedValue, errInvalidLanguages
+		}
+
+		castedValue.Set(reflect.ValueOf(a))
+
+		return castedValue, nil
+	}
+
+	if targetType == urlType {
+		u, err := parseURL(value, opts.urlKind)
+		if err != nil {
+			return castedValue, err
+		}
+
+		castedValue.Set(reflect.ValueOf(u))
+
+		return castedValue, nil
+	}
+
+	if bigValue, ok, err := castBigValue(targetType, value); ok {
+		if err != nil {
+			return castedValue, err
+		}
+
+		return bigValue, nil
+	}
+
+	if networkValue, ok, err := castNetworkValue(targetType, value); ok {
+		if err != nil {
+			return castedValue, err
+		}
+
+		return networkValue, nil
+	}
+
+	if targetType == rawMessageType {
+		return castRawMessage(value)
+	}
+
+	if isSQLNullType(targetType) {
+		return castSQLNullValue(targetType, value, opts)
+	}
+
+	if isTextUnmarshalerType(targetType) {
+		ptr := reflect.New(targetType)
+		unmarshaler := ptr.Interface().(encoding.TextUnmarshaler) //nolint:forcetypeassert
+
+		if err := unmarshaler.UnmarshalText([]byte(value)); err != nil {
+			if textErr := textTypeError(targetType); textErr != nil {
+				return castedValue, textErr
+			}
+
+			return castedValue, errInvalidValue
+		}
+
+		return ptr.Elem(), nil
+	}
+
+	switch targetType.Kind() { //nolint:exhaustive
+	case reflect.String:
+		if opts.rejectControlChars && containsControlChars(value) {
+			return castedValue, errInvalidChars
+		}
+
+		if opts.authScheme != "" {
+			var ok bool
+
+			value, ok = trimAuthScheme(value, opts.authScheme)
+			if !ok {
+				return castedValue, opts.invalidAuthErr
+			}
+		}
+
+		castedValue.SetString(value)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, opts.parseIntBase(), targetType.Bits())
+		if err != nil {
+			return castedValue, opts.integerError(err, targetType.Bits(), false)
+		}
+
+		castedValue.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, opts.parseIntBase(), targetType.Bits())
+		if err != nil {
+			return castedValue, opts.integerError(err, targetType.Bits(), true)
+		}
+
+		castedValue.SetUint(u)
+
+	case reflect.Float64:
+		if opts.decimalSep != "" {
+			var ok bool
+			if value, ok = normalizeFloat(value, opts); !ok {
+				return castedValue, errInvalidFloat
+			}
+		}
+
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return castedValue, errInvalidFloat
+		}
+
+		castedValue.SetFloat(f)
+
+	case reflect.Bool:
+		b := true
+		if value != "" || !opts.emptyBoolIsTrue {
+			var err error
+
+			b, err = parseBool(value, opts)
+			if err != nil {
+				return castedValue, errInvalidBoolean
+			}
+		}
+
+		castedValue.SetBool(b != opts.negateBool)
+	}
+
+	return castedValue, nil
+}
+
+// isTextUnmarshalerType reports whether the values of the non-pointer type are casted with the
+// [encoding.TextUnmarshaler] implementation of its pointer type. The special types of the package,
+// e.g. time.Time, are casted with their own rules instead.
+func isTextUnmarshalerType(t reflect.Type) bool {
+	return t.Kind() != reflect.Pointer && t != timeType &&
+		reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// textTypeError returns the validation error of a type decoded from text with its own rules, e.g.
+// a UUID or an IP address, or nil if the type has no specific validation error.
+func textTypeError(t reflect.Type) error {
+	switch {
+	case t == netipAddrType || t == netIPType:
+		return errInvalidIPAddress
+	case t == netipPrefixType:
+		return errInvalidIPPrefix
+	case t == bigIntType:
+		return errInvalidInteger
+	case t == bigFloatType:
+		return errInvalidFloat
+	case isUUIDType(t):
+		return errInvalidUUID
+	default:
+		return nil
+	}
+}
+
+// parseBool parses the value with the bool tokens of the options. If the options have no bool
+// tokens, [strconv.ParseBool] is used. Only "true" and "false" are valid if strictBools is set.
+func parseBool(value string, opts castOptions) (bool, error) {
+	if opts.strictBools {
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		default:
+			return false, errInvalidBoolean
+		}
+	}
+
+	if opts.trueTokens == nil {
+		return strconv.ParseBool(value)
+	}
+
+	value = strings.ToLower(value)
+
+	for _, token := range opts.trueTokens {
+		if value == token {
+			return true, nil
+		}
+	}
+
+	for _, token := range opts.falseTokens {
+		if value == token {
+			return false, nil
+		}
+	}
+
+	return false, errInvalidBoolean
+}
+
+// parseDuration parses the value with [time.ParseDuration] first. If it fails and unit is not
+// zero, the value is parsed as an integer number of units.
+func parseDuration(value string, unit time.Duration) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err == nil || unit == 0 {
+		return d, err
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if n > math.MaxInt64/int64(unit) || n < math.MinInt64/int64(unit) {
+		return 0, strconv.ErrRange
+	}
+
+	return time.Duration(n) * unit, nil
+}
+
+// parseTime parses the value as a Unix timestamp if the options have a Unix time unit. Otherwise,
+// the value is parsed with the time layouts of the options in order and the first successful
+// result is returned.
+func parseTime(value string, opts castOptions) (time.Time, error) {
+	if opts.unixTimeUnit != 0 {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		unitsPerSecond := int64(time.Second / opts.unixTimeUnit)
+
+		return time.Unix(n/unitsPerSecond, n%unitsPerSecond*int64(opts.unixTimeUnit)).UTC(), nil
+	}
+
+	var err error
+
+	for _, layout := range opts.timeLayouts {
+		var t time.Time
+
+		t, err = time.Parse(layout, value)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+// containsControlChars reports whether the value contains any control (e.g. null byte, tab,
+// newline) or format (e.g. zero width space) characters.
+func containsControlChars(value string) bool {
+	for _, r := range value {
+		if unicode.IsControl(r) || unicode.Is(unicode.Cf, r) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// catchAllKey is the tag value of the field receiving the values whose keys are not matched by the
+// other fields, e.g. `query:"*"`.
+const catchAllKey = "*"
+
+var catchAllType = reflect.TypeOf(map[string][]string{}) //nolint:gochecknoglobals
+
+// setCatchAllField sets the field as the catch-all field of the plan. The field must be of type
+// map[string][]string and there can be only one catch-all field, which must be declared in the
+// top-level struct. nested reports whether the plan is of a nested or embedded struct.
+func (p *structPlan) setCatchAllField(
+	structField reflect.StructField,
+	source bindingSource,
+	nested bool,
+) error {
+	if structField.Type != catchAllType {
+		return fmt.Errorf(
+			"%w: %s (%s)", ErrInvalidQueryFieldType, structField.Name, structField.Type,
+		)
+	}
+
+	if nested || p.catchAllIndex >= 0 {
+		return fmt.Errorf(
+			"%w: %s:%q (%s)", ErrInvalidTag, source.tagName, catchAllKey, structField.Name,
+		)
+	}
+
+	p.catchAllIndex = structField.Index[len(structField.Index)-1]
+
+	return nil
+}
+
+// populateCatchAll sets the values whose keys are not matched by the fields of the plan into the
+// catch-all field. The values are copied, so the field doesn't share memory with the source.
+func (p *structPlan) populateCatchAll(
+	fieldv reflect.Value,
+	values map[string][]string,
+	opts *ParseQueryOptions,
+) {
+	unmatched := make(map[string][]string)
+
+	for key, keyValues := range values {
+		if len(keyValues) == 0 || p.matchesKey(key, opts) {
+			continue
+		}
+
+		unmatched[key] = append([]string(nil), keyValues...)
+	}
+
+	fieldv.Set(reflect.ValueOf(unmatched))
+}
+
+// matchesKey reports whether the key is bound to one of the fields of the plan, including the
+// fields of the nested structs and the keys collected by the map fields.
+func (p *structPlan) matchesKey(key string, opts *ParseQueryOptions) bool {
+	for i := range p.fields {
+		field := &p.fields[i]
+
+		switch {
+		case field.nested != nil:
+			if field.nested.matchesKey(key, opts) {
+				return true
+			}
+		case field.kind == reflect.Map && !field.jsonEncoded:
+			if _, ok := mapKeyFromQueryKey(key, field.key, field.resolvedMapKeyStyle(opts)); ok {
+				return true
+			}
+		case key == field.key:
+			return true
+		case field.kind == reflect.Slice || field.pointerToSlice:
+			if _, ok := arrayIndexFromKey(key, field.key); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryCatchAll(t *testing.T) {
+	t.Parallel()
+
+	type Filter struct {
+		Status string `query:"status"`
+	}
+
+	type MyStruct struct {
+		Page   int                 `query:"page"   default:"1"`
+		Tags   []string            `query:"tag"`
+		Meta   map[string]string   `query:"meta"`
+		Filter *Filter             `query:"filter"`
+		Rest   map[string][]string `query:"*"`
+	}
+
+	t.Run("unmatched params", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"page":          {"2"},
+			"tag":           {"a", "b"},
+			"meta[color]":   {"red"},
+			"meta.size":     {"large"},
+			"filter.status": {"active"},
+			"filter.other":  {"x"},
+			"utm_source":    {"newsletter"},
+			"ids":           {"1", "2"},
+			"empty":         {},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string][]string{
+			"meta.size":    {"large"},
+			"filter.other": {"x"},
+			"utm_source":   {"newsletter"},
+			"ids":          {"1", "2"},
+		}, s.Rest)
+
+		// The values are copied from the source.
+		s.Rest["ids"][0] = "3"
+		assert.Equal(t, "1", inputQueryParams["ids"][0])
+	})
+
+	t.Run("no unmatched params", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"page": {"3"}}, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string][]string{}, s.Rest)
+	})
+
+	t.Run("map key style", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"meta[color]": {"red"}, "meta.size": {"large"}},
+			&s,
+			&reqparse.ParseQueryOptions{MapKeyStyle: reqparse.MapKeyStyleDot},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string][]string{"meta[color]": {"red"}}, s.Rest)
+	})
+
+	t.Run("invalid catch-all fields", func(t *testing.T) {
+		t.Parallel()
+
+		type WrongType struct {
+			Rest map[string]string `query:"*"`
+		}
+
+		err := reqparse.ParseQuery(map[string][]string{}, &WrongType{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+
+		type TwoFields struct {
+			Rest  map[string][]string `query:"*"`
+			Other map[string][]string `query:"*"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &TwoFields{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type Nested struct {
+			Rest map[string][]string `query:"*"`
+			Name string              `query:"name"`
+		}
+
+		type NestedCatchAll struct {
+			Nested Nested `query:"nested"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &NestedCatchAll{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
@@ -0,0 +1,52 @@
+// Package chirouter binds the URL parameters of the requests routed by [chi] with reqparse.
+//
+// [chi]: https://github.com/go-chi/chi
+package chirouter
+
+import (
+	"net/http"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/go-chi/chi/v5"
+)
+
+// PathParams returns the URL parameters of the request matched by chi. If a parameter is matched
+// by multiple nested routers, the innermost value is used like [chi.URLParam]. Empty values, e.g.
+// the "*" parameter of mounted routers, are treated as absent. It can be used as
+// [reqparse.ParseQueryOptions.PathParams].
+func PathParams(r *http.Request) map[string]string {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil {
+		return map[string]string{}
+	}
+
+	pathParams := make(map[string]string, len(rctx.URLParams.Keys))
+	for i, key := range rctx.URLParams.Keys {
+		if value := rctx.URLParams.Values[i]; value != "" {
+			pathParams[key] = value
+		}
+	}
+
+	return pathParams
+}
+
+// ParsePath parses the URL parameters of the request into given struct with [reqparse.ParsePath].
+// If options are nil, default options are used.
+func ParsePath(r *http.Request, target any, opts *reqparse.ParseQueryOptions) error {
+	return reqparse.ParsePath(PathParams(r), target, opts)
+}
+
+// Bind parses the URL parameters and the query parameters of the request into given struct with
+// [reqparse.ParseRequest], using the URL parameters matched by chi for the fields with the `path`
+// tag. Fields with the `header`, `cookie` and `json` tags are supported as well. If options are
+// nil, default options are used; the PathParams option is always replaced by [PathParams].
+func Bind(r *http.Request, target any, opts *reqparse.ParseQueryOptions) error {
+	bindOpts := reqparse.ParseQueryOptions{}
+	if opts != nil {
+		bindOpts = *opts
+	}
+
+	bindOpts.PathParams = PathParams
+
+	return reqparse.ParseRequest(r, target, &bindOpts)
+}
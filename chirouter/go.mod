@@ -0,0 +1,19 @@
+module github.com/berk-karaal/reqparse/chirouter
+
+go 1.18
+
+require (
+	github.com/berk-karaal/reqparse v0.2.0
+	github.com/go-chi/chi/v5 v5.2.0
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The reqparse module in the parent directory is used while developing in this repository. The
+// required version above is used by the dependents, so it must be a tagged release of reqparse.
+replace github.com/berk-karaal/reqparse => ../
@@ -0,0 +1,740 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var errUnsupported = errors.New("unsupported")
+
+// valueTypes are the supported types of the field values. Fields can also be slices of or pointers
+// to them.
+var valueTypes = map[string]bool{ //nolint:gochecknoglobals
+	"string":        true,
+	"int":           true,
+	"float64":       true,
+	"bool":          true,
+	"time.Time":     true,
+	"time.Duration": true,
+}
+
+// durationUnits are the units accepted by the `durationunit` tag, their Go expressions and names
+// used in the validation error messages. They match the units of the reqparse package.
+var durationUnits = map[string][2]string{ //nolint:gochecknoglobals
+	"ns": {"time.Nanosecond", "nanoseconds"},
+	"us": {"time.Microsecond", "microseconds"},
+	"µs": {"time.Microsecond", "microseconds"},
+	"ms": {"time.Millisecond", "milliseconds"},
+	"s":  {"time.Second", "seconds"},
+	"m":  {"time.Minute", "minutes"},
+	"h":  {"time.Hour", "hours"},
+}
+
+// supportedTags are the struct tags the generated parsers implement. Generation fails for the
+// other struct tags, except ignoredTags, so that the generated parsers never accept values that
+// are rejected by the reqparse package.
+var supportedTags = map[string]bool{ //nolint:gochecknoglobals
+	"query":        true,
+	"default":      true,
+	"required":     true,
+	"unique":       true,
+	"negate":       true,
+	"stripsuffix":  true,
+	"booltokens":   true,
+	"layout":       true,
+	"durationunit": true,
+}
+
+// ignoredTags are the struct tags of other packages that are not read while parsing query
+// parameters.
+var ignoredTags = map[string]bool{ //nolint:gochecknoglobals
+	"json": true,
+	"xml":  true,
+	"yaml": true,
+}
+
+// structInfo describes a struct type to generate a parser for.
+type structInfo struct {
+	name   string
+	fields []fieldInfo
+}
+
+// fieldInfo describes a field of a struct type bound from a query parameter.
+type fieldInfo struct {
+	name string
+	key  string
+
+	// container is "slice" or "pointer" for slice and pointer fields, and empty otherwise.
+	container string
+
+	// valueType is one of valueTypes.
+	valueType string
+
+	defaultValue string
+	hasDefault   bool
+	required     bool
+	unique       bool
+	suffixes     []string
+	negate       bool
+
+	trueTokens  []string
+	falseTokens []string
+
+	layouts []string
+
+	// durationUnit is the Go expression of the duration unit, e.g. "time.Second".
+	durationUnit     string
+	durationUnitName string
+}
+
+// generate parses the Go files of the package in dir and returns the formatted source of the
+// parsers of the given types. The output file is skipped while parsing the package.
+func generate(dir string, typeNames []string, output string) ([]byte, error) {
+	files, err := parsePackage(dir, filepath.Base(output))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+
+	g := &generator{imports: make(map[string]bool)}
+
+	for _, typeName := range typeNames {
+		structType := findStructType(files, typeName)
+		if structType == nil {
+			return nil, fmt.Errorf("struct type %s not found in %s", typeName, dir)
+		}
+
+		info, err := newStructInfo(typeName, structType)
+		if err != nil {
+			return nil, err
+		}
+
+		g.writeStruct(info)
+	}
+
+	return g.source(files[0].Name.Name)
+}
+
+// parsePackage parses the non-test Go files in dir except the output file.
+func parsePackage(dir string, output string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(entries))
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") ||
+			strings.HasSuffix(name, "_test.go") || name == output {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// findStructType returns the struct type with the given name declared in the files, or nil if
+// there is no such type.
+func findStructType(files []*ast.File, typeName string) *ast.StructType {
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec) //nolint:forcetypeassert
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok &&
+					typeSpec.Name.Name == typeName {
+					return structType
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// newStructInfo reads the fields of the struct type. It returns an error for the fields the
+// generator doesn't support.
+func newStructInfo(typeName string, structType *ast.StructType) (structInfo, error) {
+	info := structInfo{name: typeName}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			return info, fmt.Errorf("%w embedded field in %s", errUnsupported, typeName)
+		}
+
+		var tag reflect.StructTag
+		if field.Tag != nil { //nolint:wsl
+			tagValue, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return info, err
+			}
+
+			tag = reflect.StructTag(tagValue)
+		}
+
+		for _, name := range field.Names {
+			key, ok := tag.Lookup("query")
+			if key == "-" {
+				continue
+			}
+
+			if !ok {
+				return info, fmt.Errorf(
+					"query tag not found for struct field: %s.%s", typeName, name,
+				)
+			}
+
+			fieldInfo, err := newFieldInfo(name.Name, key, field.Type, tag)
+			if err != nil {
+				return info, fmt.Errorf("%s.%s: %w", typeName, name, err)
+			}
+
+			info.fields = append(info.fields, fieldInfo)
+		}
+	}
+
+	return info, nil
+}
+
+// newFieldInfo reads the type and the struct tags of a field.
+func newFieldInfo( //nolint:cyclop,funlen
+	name string,
+	key string,
+	fieldType ast.Expr,
+	tag reflect.StructTag,
+) (fieldInfo, error) {
+	info := fieldInfo{
+		name:     name,
+		key:      key,
+		required: tag.Get("required") == "true",
+		unique:   tag.Get("unique") == "true",
+		negate:   tag.Get("negate") == "true",
+		layouts:  []string{time.RFC3339},
+	}
+
+	switch t := fieldType.(type) {
+	case *ast.ArrayType:
+		if t.Len == nil {
+			info.container = "slice"
+			fieldType = t.Elt
+		}
+	case *ast.StarExpr:
+		info.container = "pointer"
+		fieldType = t.X
+	}
+
+	info.valueType = typeString(fieldType)
+	if !valueTypes[info.valueType] {
+		return info, fmt.Errorf("%w field type %s", errUnsupported, typeString(fieldType))
+	}
+
+	for _, tagName := range tagKeys(tag) {
+		if !supportedTags[tagName] && !ignoredTags[tagName] {
+			return info, fmt.Errorf("%w tag %s", errUnsupported, tagName)
+		}
+	}
+
+	if tag.Get("unique") == "dedupe" {
+		return info, fmt.Errorf("%w tag value unique:%q", errUnsupported, "dedupe")
+	}
+
+	info.defaultValue, info.hasDefault = tag.Lookup("default")
+
+	if suffixes, ok := tag.Lookup("stripsuffix"); ok &&
+		(info.valueType == "int" || info.valueType == "float64") {
+		for _, suffix := range strings.Split(suffixes, ",") {
+			if suffix != "" {
+				info.suffixes = append(info.suffixes, suffix)
+			}
+		}
+	}
+
+	if unitName, ok := tag.Lookup("durationunit"); ok {
+		unit, ok := durationUnits[unitName]
+		if !ok {
+			return info, fmt.Errorf("invalid struct tag value: durationunit:%q", unitName)
+		}
+
+		info.durationUnit, info.durationUnitName = unit[0], unit[1]
+	}
+
+	if layout, ok := tag.Lookup("layout"); ok {
+		info.layouts = strings.Split(layout, "|")
+	}
+
+	if boolTokens, ok := tag.Lookup("booltokens"); ok {
+		trueTokens, falseTokens, found := strings.Cut(strings.ToLower(boolTokens), ":")
+		if !found || trueTokens == "" || falseTokens == "" {
+			return info, fmt.Errorf("invalid struct tag value: booltokens:%q", boolTokens)
+		}
+
+		// True tokens are matched first, so the false tokens that are also true tokens are
+		// dropped. Duplicate cases are not allowed in the generated switch statement.
+		seen := make(map[string]bool)
+		info.trueTokens = uniqueTokens(strings.Split(trueTokens, ","), seen)
+		info.falseTokens = uniqueTokens(strings.Split(falseTokens, ","), seen)
+	}
+
+	return info, nil
+}
+
+// tagKeys returns the keys of the struct tag in the order they are written. Parsing stops at the
+// first malformed key:"value" pair like [reflect.StructTag.Lookup] does.
+func tagKeys(tag reflect.StructTag) []string {
+	var keys []string
+
+	for tag != "" {
+		tag = reflect.StructTag(strings.TrimLeft(string(tag), " "))
+
+		i := strings.IndexByte(string(tag), ':')
+		if i <= 0 || i+1 >= len(tag) || tag[i+1] != '"' {
+			break
+		}
+
+		key := string(tag[:i])
+		tag = tag[i+1:]
+
+		// Scan the quoted value, skipping the escaped characters.
+		j := 1
+		for j < len(tag) && tag[j] != '"' {
+			if tag[j] == '\\' {
+				j++
+			}
+			j++ //nolint:wsl
+		}
+
+		if j >= len(tag) || strings.ContainsAny(key, " \"") {
+			break
+		}
+
+		keys = append(keys, key)
+		tag = tag[j+1:]
+	}
+
+	return keys
+}
+
+// uniqueTokens returns the tokens that are not in seen, and adds them to seen.
+func uniqueTokens(tokens []string, seen map[string]bool) []string {
+	unique := make([]string, 0, len(tokens))
+
+	for _, token := range tokens {
+		if !seen[token] {
+			seen[token] = true
+			unique = append(unique, token)
+		}
+	}
+
+	return unique
+}
+
+// typeString returns the Go source of a type expression, e.g. "time.Time".
+func typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return typeString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + typeString(t.X)
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "[" + typeString(t.Len) + "]" + typeString(t.Elt)
+		}
+
+		return "[]" + typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(t.Key) + "]" + typeString(t.Value)
+	case *ast.BasicLit:
+		return t.Value
+	default:
+		return fmt.Sprintf("%T", expr)
+	}
+}
+
+// generator writes the source of the generated parsers and records the imported packages.
+type generator struct {
+	buf     bytes.Buffer
+	imports map[string]bool
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// source returns the formatted source of the generated file.
+func (g *generator) source(pkgName string) ([]byte, error) {
+	var src bytes.Buffer
+
+	fmt.Fprintf(&src, "// Code generated by reqparsegen. DO NOT EDIT.\n\npackage %s\n\n", pkgName)
+
+	imports := make([]string, 0, len(g.imports)+1)
+	for path := range g.imports { //nolint:wsl
+		imports = append(imports, path)
+	}
+	sort.Strings(imports) //nolint:wsl
+
+	src.WriteString("import (\n")
+	for _, path := range imports { //nolint:wsl
+		fmt.Fprintf(&src, "\t%q\n", path)
+	}
+	src.WriteString("\n\t\"github.com/berk-karaal/reqparse\"\n)\n") //nolint:wsl
+
+	src.Write(g.buf.Bytes())
+
+	return format.Source(src.Bytes())
+}
+
+// funcName returns the name of the generated parser function of the struct type. The function is
+// exported only if the struct type is exported.
+func funcName(typeName string) string {
+	runes := []rune(typeName)
+	if unicode.IsUpper(runes[0]) {
+		return "ParseQueryInto" + typeName
+	}
+
+	return "parseQueryInto" + string(unicode.ToUpper(runes[0])) + string(runes[1:])
+}
+
+// writeStruct writes the parser function of the struct type.
+func (g *generator) writeStruct(info structInfo) {
+	name := funcName(info.name)
+
+	g.printf("\n// %s parses query parameters into target the same way as\n", name)
+	g.printf("// reqparse.ParseQuery with default options.\n")
+	g.printf("func %s(values map[string][]string, target *%s) error {\n", name, info.name)
+	g.printf("validationErrors := &reqparse.QueryValidationError{\n")
+	g.printf("Source: reqparse.SourceQuery,\n")
+	g.printf("FieldErrors: make(map[string][]string),\n")
+	g.printf("StructErrors: make([]string, 0),\n")
+	g.printf("}\n\n")
+
+	for _, field := range info.fields {
+		g.writeField(field)
+	}
+
+	g.printf("if len(validationErrors.FieldErrors) > 0 {\n")
+	g.printf("return validationErrors\n")
+	g.printf("}\n\n")
+	g.printf("return nil\n")
+	g.printf("}\n")
+}
+
+// writeField writes the code binding the query parameter of the field. The code is wrapped in a
+// function literal so that it can return early like the reflection based implementation.
+func (g *generator) writeField(field fieldInfo) { //nolint:cyclop,funlen
+	key := strconv.Quote(field.key)
+	requiredSlice := !field.hasDefault && field.required && field.container == "slice"
+
+	g.printf("// %s\n", field.name)
+	g.printf("func() {\n")
+
+	if requiredSlice {
+		g.printf("fieldValues, present := values[%s]\n", key)
+	} else {
+		g.printf("fieldValues := values[%s]\n", key)
+	}
+
+	g.printf("if len(fieldValues) == 0 {\n")
+
+	switch {
+	case requiredSlice:
+		// A present key without values sets an empty slice.
+		g.printf("if !present {\n")
+		g.writeFieldError(key, "reqparse.CodeRequired", "field is required", "", false)
+		g.printf("return\n")
+		g.printf("}\n\n")
+		g.printf("target.%s = []%s{}\n", field.name, field.valueType)
+	case !field.hasDefault && field.required:
+		g.writeFieldError(key, "reqparse.CodeRequired", "field is required", "", false)
+	case !field.hasDefault && field.container == "slice":
+		g.printf("target.%s = []%s{}\n", field.name, field.valueType)
+	case !field.hasDefault && field.container == "pointer":
+		g.printf("target.%s = nil\n", field.name)
+	case !field.hasDefault:
+		g.writeFieldError(key, "reqparse.CodeRequired", "field is required", "", false)
+	case field.container == "pointer" && field.defaultValue == "":
+		// Empty default value of a pointer field explicitly means nil.
+		g.printf("target.%s = nil\n", field.name)
+	}
+
+	if field.hasDefault && !(field.container == "pointer" && field.defaultValue == "") {
+		defaults := []string{field.defaultValue}
+		if field.container == "slice" {
+			defaults = strings.Split(field.defaultValue, ",")
+		}
+
+		g.printf("fieldValues = []string{%s}\n", quoteAll(defaults))
+	} else {
+		g.printf("return\n")
+	}
+
+	g.printf("}\n\n")
+
+	if len(field.suffixes) > 0 {
+		g.imports["strings"] = true
+
+		g.printf("stripped := make([]string, len(fieldValues))\n")
+		g.printf("for i, value := range fieldValues {\n")
+		g.printf("stripped[i] = value\n")
+		g.printf("for _, suffix := range []string{%s} {\n", quoteAll(field.suffixes))
+		g.printf("if strings.HasSuffix(value, suffix) {\n")
+		g.printf("stripped[i] = strings.TrimSuffix(value, suffix)\n")
+		g.printf("break\n")
+		g.printf("}\n}\n}\n")
+		g.printf("fieldValues = stripped\n\n")
+	}
+
+	g.printf("cast := func(value string) (%s, bool) {\n", field.valueType)
+	g.writeCast(field)
+	g.printf("}\n\n")
+
+	code, message, params := castErrorOf(field)
+
+	switch {
+	case field.valueType == "string" && field.container == "slice":
+		// Strings are never invalid.
+		g.printf("casted := make([]string, len(fieldValues))\n")
+		g.printf("for i, fieldValue := range fieldValues {\n")
+		g.printf("casted[i], _ = cast(fieldValue)\n")
+		g.printf("}\n\n")
+		g.printf("target.%s = casted\n", field.name)
+
+		if field.unique {
+			g.writeUniqueCheck(key, field.valueType)
+		}
+	case field.valueType == "string":
+		g.printf("value, _ := cast(fieldValues[0])\n")
+		g.writeAssign(field)
+	case field.container == "slice":
+		g.printf("casted := make([]%s, len(fieldValues))\n", field.valueType)
+
+		if field.unique {
+			g.printf("castFailed := false\n")
+		}
+
+		g.printf("for i, fieldValue := range fieldValues {\n")
+		g.printf("value, ok := cast(fieldValue)\n")
+		g.printf("if !ok {\n")
+		g.printf("index := i\n")
+		g.writeFieldError(key, code, message, params, true)
+
+		if field.unique {
+			g.printf("castFailed = true\n")
+		}
+
+		g.printf("continue\n")
+		g.printf("}\n\n")
+		g.printf("casted[i] = value\n")
+		g.printf("}\n\n")
+		g.printf("target.%s = casted\n", field.name)
+
+		if field.unique {
+			g.printf("if castFailed {\n")
+			g.printf("return\n")
+			g.printf("}\n\n")
+			g.writeUniqueCheck(key, field.valueType)
+		}
+	default:
+		g.printf("value, ok := cast(fieldValues[0])\n")
+		g.printf("if !ok {\n")
+		g.writeFieldError(key, code, message, params, false)
+		g.printf("return\n")
+		g.printf("}\n\n")
+		g.writeAssign(field)
+	}
+
+	g.printf("}()\n\n")
+}
+
+// writeAssign writes the code setting the casted value of a field that is not a slice.
+func (g *generator) writeAssign(field fieldInfo) {
+	if field.container == "pointer" {
+		g.printf("target.%s = &value\n", field.name)
+	} else {
+		g.printf("target.%s = value\n", field.name)
+	}
+}
+
+// writeUniqueCheck writes the code reporting the duplicate values of the casted slice.
+func (g *generator) writeUniqueCheck(key, valueType string) {
+	g.printf("seen := make(map[%s]struct{}, len(casted))\n", valueType)
+	g.printf("for _, value := range casted {\n")
+	g.printf("if _, ok := seen[value]; ok {\n")
+	g.writeFieldError(key, "reqparse.CodeNotUnique", "values must be unique", "", false)
+	g.printf("break\n")
+	g.printf("}\n\n")
+	g.printf("seen[value] = struct{}{}\n")
+	g.printf("}\n")
+}
+
+// writeFieldError writes the code reporting a field error with the code, the message and the
+// parameters, which are Go expressions except the message. If indexed is true, the error is
+// reported for the slice element at the index in the index variable.
+func (g *generator) writeFieldError(key, code, message, params string, indexed bool) {
+	g.printf("validationErrors.AddFieldErrorDetail(reqparse.FieldError{\n")
+	g.printf("Field: %s,\n", key)
+	g.printf("Code: %s,\n", code)
+	g.printf("Message: %q,\n", message)
+
+	if indexed {
+		g.printf("Index: &index,\n")
+	}
+
+	if params != "" {
+		g.printf("Params: %s,\n", params)
+	}
+
+	g.printf("})\n")
+}
+
+// castErrorOf returns the code, the message and the parameters of the error of the values that
+// can't be casted to the value type of the field. They match the errors of the reqparse package.
+func castErrorOf(field fieldInfo) (string, string, string) {
+	switch field.valueType {
+	case "int":
+		return "reqparse.CodeInvalidInteger", "must be a valid integer", ""
+	case "float64":
+		return "reqparse.CodeInvalidFloat", "must be a valid float", ""
+	case "bool":
+		return "reqparse.CodeInvalidBoolean", "must be a valid boolean", ""
+	case "time.Time":
+		return "reqparse.CodeInvalidDate", "must be a valid date", ""
+	case "time.Duration":
+		if field.durationUnit == "" {
+			return "reqparse.CodeInvalidDuration", "must be a valid duration", ""
+		}
+
+		return "reqparse.CodeInvalidDuration",
+			"must be a valid duration or number of " + field.durationUnitName,
+			fmt.Sprintf("map[string]any{\"unit\": %q}", field.durationUnitName)
+	default:
+		// Strings are never invalid, see writeField.
+		return "", "", ""
+	}
+}
+
+// writeCast writes the body of the function casting a value of the field. The function returns
+// the casted value and false if the value is invalid.
+func (g *generator) writeCast(field fieldInfo) { //nolint:cyclop,funlen
+	switch field.valueType {
+	case "string":
+		g.printf("return value, true\n")
+
+	case "int":
+		g.imports["strconv"] = true
+
+		g.printf("i, err := strconv.Atoi(value)\n")
+		g.printf("return i, err == nil\n")
+
+	case "float64":
+		g.imports["strconv"] = true
+
+		g.printf("f, err := strconv.ParseFloat(value, 64)\n")
+		g.printf("return f, err == nil\n")
+
+	case "bool":
+		negate := ""
+		if field.negate {
+			negate = "!"
+		}
+
+		if field.trueTokens == nil {
+			g.imports["strconv"] = true
+
+			g.printf("b, err := strconv.ParseBool(value)\n")
+			g.printf("if err != nil {\n")
+			g.printf("return false, false\n")
+			g.printf("}\n\n")
+			g.printf("return %sb, true\n", negate)
+
+			break
+		}
+
+		g.imports["strings"] = true
+
+		g.printf("switch strings.ToLower(value) {\n")
+		g.printf("case %s:\n", quoteAll(field.trueTokens))
+		g.printf("return %t, true\n", !field.negate)
+
+		if len(field.falseTokens) > 0 {
+			g.printf("case %s:\n", quoteAll(field.falseTokens))
+			g.printf("return %t, true\n", field.negate)
+		}
+
+		g.printf("}\n\n")
+		g.printf("return false, false\n")
+
+	case "time.Time":
+		g.imports["time"] = true
+
+		g.printf("for _, layout := range []string{%s} {\n", quoteAll(field.layouts))
+		g.printf("if t, err := time.Parse(layout, value); err == nil {\n")
+		g.printf("return t, true\n")
+		g.printf("}\n}\n\n")
+		g.printf("return time.Time{}, false\n")
+
+	case "time.Duration":
+		g.imports["time"] = true
+
+		g.printf("d, err := time.ParseDuration(value)\n")
+		g.printf("if err == nil {\n")
+		g.printf("return d, true\n")
+		g.printf("}\n\n")
+
+		if field.durationUnit == "" {
+			g.printf("return 0, false\n")
+			break
+		}
+
+		g.imports["strconv"] = true
+		g.imports["math"] = true
+
+		g.printf("n, err := strconv.ParseInt(value, 10, 64)\n")
+		g.printf("unit := int64(%s)\n", field.durationUnit)
+		g.printf("if err != nil || n > math.MaxInt64/unit || n < math.MinInt64/unit {\n")
+		g.printf("return 0, false\n")
+		g.printf("}\n\n")
+		g.printf("return time.Duration(n * unit), true\n")
+	}
+}
+
+// quoteAll returns the comma separated Go string literals of the values.
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values { //nolint:wsl
+		quoted[i] = strconv.Quote(value)
+	}
+
+	return strings.Join(quoted, ", ")
+}
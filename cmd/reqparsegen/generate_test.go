@@ -0,0 +1,126 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("generated example is up to date", func(t *testing.T) {
+		t.Parallel()
+
+		dir := filepath.Join("internal", "example")
+
+		expected, err := os.ReadFile(filepath.Join(dir, "searchparams_reqparse.go"))
+		require.NoError(t, err)
+
+		src, err := generate(dir, []string{"SearchParams", "filters"}, "searchparams_reqparse.go")
+		require.NoError(t, err)
+		assert.Equal(t, string(expected), string(src))
+	})
+
+	t.Run("write output file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := writePackage(t, "type Params struct {\n\tPage int `query:\"page\"`\n}\n")
+
+		require.NoError(t, run(dir, []string{"Params"}, ""))
+
+		src, err := os.ReadFile(filepath.Join(dir, "params_reqparse.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(src), "func ParseQueryIntoParams(values map[string][]string")
+
+		// The previously generated file is skipped while parsing the package.
+		require.NoError(t, run(dir, []string{"Params"}, ""))
+	})
+
+	t.Run("tags of other packages", func(t *testing.T) {
+		t.Parallel()
+
+		dir := writePackage(t, "type Params struct {\n\tP int `query:\"p\" json:\"p\"`\n}\n")
+
+		_, err := generate(dir, []string{"Params"}, "out.go")
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid struct definitions", func(t *testing.T) {
+		t.Parallel()
+
+		tests := map[string]struct {
+			src         string
+			expectedErr string
+		}{
+			"type not found": {
+				src:         "type Other struct{}\n",
+				expectedErr: "struct type Params not found",
+			},
+			"tag not found": {
+				src:         "type Params struct {\n\tPage int\n}\n",
+				expectedErr: "query tag not found for struct field: Params.Page",
+			},
+			"unsupported type": {
+				src:         "type Params struct {\n\tF map[string]string `query:\"f\"`\n}\n",
+				expectedErr: "Params.F: unsupported field type map[string]string",
+			},
+			"unsupported tag": {
+				src:         "type Params struct {\n\tN int `query:\"n\" preset:\"p\"`\n}\n",
+				expectedErr: "Params.N: unsupported tag preset",
+			},
+			"validate tag": {
+				src:         "type Params struct {\n\tN int `query:\"n\" validate:\"min=1\"`\n}\n",
+				expectedErr: "Params.N: unsupported tag validate",
+			},
+			"delimiter tag": {
+				src:         "type Params struct {\n\tN []int `query:\"n\" delimiter:\",\"`\n}\n",
+				expectedErr: "Params.N: unsupported tag delimiter",
+			},
+			"unknown tag": {
+				src:         "type Params struct {\n\tN int `query:\"n\" base:\"8\"`\n}\n",
+				expectedErr: "Params.N: unsupported tag base",
+			},
+			"unsupported tag value": {
+				src:         "type Params struct {\n\tN []int `query:\"n\" unique:\"dedupe\"`\n}\n",
+				expectedErr: `Params.N: unsupported tag value unique:"dedupe"`,
+			},
+			"embedded field": {
+				src:         "type Base struct{}\n\ntype Params struct {\n\tBase\n}\n",
+				expectedErr: "unsupported embedded field in Params",
+			},
+			"invalid tag value": {
+				src:         "type Params struct {\n\tB bool `query:\"b\" booltokens:\"yes\"`\n}\n",
+				expectedErr: `Params.B: invalid struct tag value: booltokens:"yes"`,
+			},
+		}
+
+		for name, tt := range tests {
+			tt := tt
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := generate(writePackage(t, tt.src), []string{"Params"}, "out.go")
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+			})
+		}
+	})
+}
+
+// writePackage writes a package with the given declarations into a temporary directory and
+// returns the directory.
+func writePackage(t *testing.T, decls string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	src := "package params\n\n" + decls
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "params.go"), []byte(src), 0o600))
+
+	return dir
+}
@@ -0,0 +1,32 @@
+// Package example contains struct types used for testing the parsers generated by reqparsegen
+// against reqparse.ParseQuery.
+package example
+
+import "time"
+
+//go:generate go run github.com/berk-karaal/reqparse/cmd/reqparsegen -type=SearchParams,filters
+
+// SearchParams covers the field types and struct tags supported by reqparsegen.
+type SearchParams struct {
+	Query    string          `query:"q"`
+	Page     int             `query:"page"     default:"1"`
+	Limit    *int            `query:"limit"`
+	Price    float64         `query:"price"    default:"10" stripsuffix:"$,USD"`
+	Tags     []string        `query:"tag"      unique:"true"`
+	IDs      []int           `query:"id"       default:"1,2"`
+	Verbose  bool            `query:"verbose"  default:"false"`
+	Hidden   bool            `query:"visible"  default:"true" negate:"true"`
+	Enabled  *bool           `query:"enabled"  booltokens:"yes,on:no,off"`
+	Since    *time.Time      `query:"since"`
+	Until    time.Time       `query:"until"    default:"2024-01-02" layout:"2006-01-02|2006-01"`
+	Timeout  time.Duration   `query:"timeout"  default:"30" durationunit:"s"`
+	Delays   []time.Duration `query:"delay"`
+	Required []string        `query:"required" required:"true"`
+	Cursor   *string         `query:"cursor"   default:""`
+	Internal string          `query:"-"`
+}
+
+// filters covers an unexported struct type.
+type filters struct {
+	Color string `query:"color" default:"red"`
+}
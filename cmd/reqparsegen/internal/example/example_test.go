@@ -0,0 +1,112 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedParsers(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]map[string][]string{
+		"only required": {
+			"q":        {"shoes"},
+			"required": {"x"},
+		},
+		"all present": {
+			"q":        {"shoes", "boots"},
+			"page":     {"3"},
+			"limit":    {"20"},
+			"price":    {"9.5USD"},
+			"tag":      {"a", "b"},
+			"id":       {"7", "8", "9"},
+			"verbose":  {"1"},
+			"visible":  {"false"},
+			"enabled":  {"ON"},
+			"since":    {"2024-01-02T03:04:05Z"},
+			"until":    {"2024-05"},
+			"timeout":  {"1m"},
+			"delay":    {"1s", "2h"},
+			"required": {"x", "y"},
+			"cursor":   {"abc"},
+		},
+		"missing required": {},
+		"invalid values": {
+			"q":        {"shoes"},
+			"page":     {"x"},
+			"limit":    {"1.5"},
+			"price":    {"10EUR"},
+			"tag":      {"a", "a"},
+			"id":       {"1", "x", "3", "y"},
+			"verbose":  {""},
+			"visible":  {"maybe"},
+			"enabled":  {"true"},
+			"since":    {"2024-01-02"},
+			"until":    {"01/02/2024"},
+			"timeout":  {"9223372036854775807"},
+			"delay":    {"1s", "x"},
+			"required": {"x"},
+		},
+		"required key without values": {
+			"q":        {"shoes"},
+			"required": {},
+		},
+		"duration units": {
+			"q":        {"shoes"},
+			"timeout":  {"45"},
+			"delay":    {"10"},
+			"required": {"x"},
+		},
+	}
+
+	for name, values := range tests {
+		values := values
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var generated, reflected SearchParams
+			generatedErr := ParseQueryIntoSearchParams(values, &generated)
+			reflectedErr := reqparse.ParseQuery(values, &reflected, nil)
+
+			assert.Equal(t, reflected, generated)
+			assertSameError(t, reflectedErr, generatedErr)
+		})
+	}
+
+	t.Run("unexported type", func(t *testing.T) {
+		t.Parallel()
+
+		for _, values := range []map[string][]string{{}, {"color": {"blue"}}} {
+			var generated, reflected filters
+			generatedErr := parseQueryIntoFilters(values, &generated)
+			reflectedErr := reqparse.ParseQuery(values, &reflected, nil)
+
+			assert.Equal(t, reflected, generated)
+			assertSameError(t, reflectedErr, generatedErr)
+		}
+	})
+}
+
+// assertSameError asserts that the errors are both nil or both validation errors with the same
+// field errors in the same order, including their codes, indexes and parameters.
+func assertSameError(t *testing.T, expected, actual error) {
+	t.Helper()
+
+	if expected == nil {
+		require.NoError(t, actual)
+		return
+	}
+
+	var expectedErr, actualErr *reqparse.QueryValidationError
+	require.ErrorAs(t, expected, &expectedErr)
+	require.ErrorAs(t, actual, &actualErr)
+
+	assert.Equal(t, expectedErr.FieldErrors, actualErr.FieldErrors)
+	assert.Equal(t, expectedErr.FieldErrorKeys(), actualErr.FieldErrorKeys())
+	assert.Equal(t, expectedErr.FieldErrorList(), actualErr.FieldErrorList())
+	assert.Equal(t, expectedErr.Error(), actualErr.Error())
+}
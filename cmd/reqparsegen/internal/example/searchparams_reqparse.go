@@ -0,0 +1,501 @@
+// Code generated by reqparsegen. DO NOT EDIT.
+
+package example
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+)
+
+// ParseQueryIntoSearchParams parses query parameters into target the same way as
+// reqparse.ParseQuery with default options.
+func ParseQueryIntoSearchParams(values map[string][]string, target *SearchParams) error {
+	validationErrors := &reqparse.QueryValidationError{
+		Source:       reqparse.SourceQuery,
+		FieldErrors:  make(map[string][]string),
+		StructErrors: make([]string, 0),
+	}
+
+	// Query
+	func() {
+		fieldValues := values["q"]
+		if len(fieldValues) == 0 {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "q",
+				Code:    reqparse.CodeRequired,
+				Message: "field is required",
+			})
+			return
+		}
+
+		cast := func(value string) (string, bool) {
+			return value, true
+		}
+
+		value, _ := cast(fieldValues[0])
+		target.Query = value
+	}()
+
+	// Page
+	func() {
+		fieldValues := values["page"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"1"}
+		}
+
+		cast := func(value string) (int, bool) {
+			i, err := strconv.Atoi(value)
+			return i, err == nil
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "page",
+				Code:    reqparse.CodeInvalidInteger,
+				Message: "must be a valid integer",
+			})
+			return
+		}
+
+		target.Page = value
+	}()
+
+	// Limit
+	func() {
+		fieldValues := values["limit"]
+		if len(fieldValues) == 0 {
+			target.Limit = nil
+			return
+		}
+
+		cast := func(value string) (int, bool) {
+			i, err := strconv.Atoi(value)
+			return i, err == nil
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "limit",
+				Code:    reqparse.CodeInvalidInteger,
+				Message: "must be a valid integer",
+			})
+			return
+		}
+
+		target.Limit = &value
+	}()
+
+	// Price
+	func() {
+		fieldValues := values["price"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"10"}
+		}
+
+		stripped := make([]string, len(fieldValues))
+		for i, value := range fieldValues {
+			stripped[i] = value
+			for _, suffix := range []string{"$", "USD"} {
+				if strings.HasSuffix(value, suffix) {
+					stripped[i] = strings.TrimSuffix(value, suffix)
+					break
+				}
+			}
+		}
+		fieldValues = stripped
+
+		cast := func(value string) (float64, bool) {
+			f, err := strconv.ParseFloat(value, 64)
+			return f, err == nil
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "price",
+				Code:    reqparse.CodeInvalidFloat,
+				Message: "must be a valid float",
+			})
+			return
+		}
+
+		target.Price = value
+	}()
+
+	// Tags
+	func() {
+		fieldValues := values["tag"]
+		if len(fieldValues) == 0 {
+			target.Tags = []string{}
+			return
+		}
+
+		cast := func(value string) (string, bool) {
+			return value, true
+		}
+
+		casted := make([]string, len(fieldValues))
+		for i, fieldValue := range fieldValues {
+			casted[i], _ = cast(fieldValue)
+		}
+
+		target.Tags = casted
+		seen := make(map[string]struct{}, len(casted))
+		for _, value := range casted {
+			if _, ok := seen[value]; ok {
+				validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+					Field:   "tag",
+					Code:    reqparse.CodeNotUnique,
+					Message: "values must be unique",
+				})
+				break
+			}
+
+			seen[value] = struct{}{}
+		}
+	}()
+
+	// IDs
+	func() {
+		fieldValues := values["id"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"1", "2"}
+		}
+
+		cast := func(value string) (int, bool) {
+			i, err := strconv.Atoi(value)
+			return i, err == nil
+		}
+
+		casted := make([]int, len(fieldValues))
+		for i, fieldValue := range fieldValues {
+			value, ok := cast(fieldValue)
+			if !ok {
+				index := i
+				validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+					Field:   "id",
+					Code:    reqparse.CodeInvalidInteger,
+					Message: "must be a valid integer",
+					Index:   &index,
+				})
+				continue
+			}
+
+			casted[i] = value
+		}
+
+		target.IDs = casted
+	}()
+
+	// Verbose
+	func() {
+		fieldValues := values["verbose"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"false"}
+		}
+
+		cast := func(value string) (bool, bool) {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return false, false
+			}
+
+			return b, true
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "verbose",
+				Code:    reqparse.CodeInvalidBoolean,
+				Message: "must be a valid boolean",
+			})
+			return
+		}
+
+		target.Verbose = value
+	}()
+
+	// Hidden
+	func() {
+		fieldValues := values["visible"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"true"}
+		}
+
+		cast := func(value string) (bool, bool) {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return false, false
+			}
+
+			return !b, true
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "visible",
+				Code:    reqparse.CodeInvalidBoolean,
+				Message: "must be a valid boolean",
+			})
+			return
+		}
+
+		target.Hidden = value
+	}()
+
+	// Enabled
+	func() {
+		fieldValues := values["enabled"]
+		if len(fieldValues) == 0 {
+			target.Enabled = nil
+			return
+		}
+
+		cast := func(value string) (bool, bool) {
+			switch strings.ToLower(value) {
+			case "yes", "on":
+				return true, true
+			case "no", "off":
+				return false, true
+			}
+
+			return false, false
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "enabled",
+				Code:    reqparse.CodeInvalidBoolean,
+				Message: "must be a valid boolean",
+			})
+			return
+		}
+
+		target.Enabled = &value
+	}()
+
+	// Since
+	func() {
+		fieldValues := values["since"]
+		if len(fieldValues) == 0 {
+			target.Since = nil
+			return
+		}
+
+		cast := func(value string) (time.Time, bool) {
+			for _, layout := range []string{"2006-01-02T15:04:05Z07:00"} {
+				if t, err := time.Parse(layout, value); err == nil {
+					return t, true
+				}
+			}
+
+			return time.Time{}, false
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "since",
+				Code:    reqparse.CodeInvalidDate,
+				Message: "must be a valid date",
+			})
+			return
+		}
+
+		target.Since = &value
+	}()
+
+	// Until
+	func() {
+		fieldValues := values["until"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"2024-01-02"}
+		}
+
+		cast := func(value string) (time.Time, bool) {
+			for _, layout := range []string{"2006-01-02", "2006-01"} {
+				if t, err := time.Parse(layout, value); err == nil {
+					return t, true
+				}
+			}
+
+			return time.Time{}, false
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "until",
+				Code:    reqparse.CodeInvalidDate,
+				Message: "must be a valid date",
+			})
+			return
+		}
+
+		target.Until = value
+	}()
+
+	// Timeout
+	func() {
+		fieldValues := values["timeout"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"30"}
+		}
+
+		cast := func(value string) (time.Duration, bool) {
+			d, err := time.ParseDuration(value)
+			if err == nil {
+				return d, true
+			}
+
+			n, err := strconv.ParseInt(value, 10, 64)
+			unit := int64(time.Second)
+			if err != nil || n > math.MaxInt64/unit || n < math.MinInt64/unit {
+				return 0, false
+			}
+
+			return time.Duration(n * unit), true
+		}
+
+		value, ok := cast(fieldValues[0])
+		if !ok {
+			validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+				Field:   "timeout",
+				Code:    reqparse.CodeInvalidDuration,
+				Message: "must be a valid duration or number of seconds",
+				Params:  map[string]any{"unit": "seconds"},
+			})
+			return
+		}
+
+		target.Timeout = value
+	}()
+
+	// Delays
+	func() {
+		fieldValues := values["delay"]
+		if len(fieldValues) == 0 {
+			target.Delays = []time.Duration{}
+			return
+		}
+
+		cast := func(value string) (time.Duration, bool) {
+			d, err := time.ParseDuration(value)
+			if err == nil {
+				return d, true
+			}
+
+			return 0, false
+		}
+
+		casted := make([]time.Duration, len(fieldValues))
+		for i, fieldValue := range fieldValues {
+			value, ok := cast(fieldValue)
+			if !ok {
+				index := i
+				validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+					Field:   "delay",
+					Code:    reqparse.CodeInvalidDuration,
+					Message: "must be a valid duration",
+					Index:   &index,
+				})
+				continue
+			}
+
+			casted[i] = value
+		}
+
+		target.Delays = casted
+	}()
+
+	// Required
+	func() {
+		fieldValues, present := values["required"]
+		if len(fieldValues) == 0 {
+			if !present {
+				validationErrors.AddFieldErrorDetail(reqparse.FieldError{
+					Field:   "required",
+					Code:    reqparse.CodeRequired,
+					Message: "field is required",
+				})
+				return
+			}
+
+			target.Required = []string{}
+			return
+		}
+
+		cast := func(value string) (string, bool) {
+			return value, true
+		}
+
+		casted := make([]string, len(fieldValues))
+		for i, fieldValue := range fieldValues {
+			casted[i], _ = cast(fieldValue)
+		}
+
+		target.Required = casted
+	}()
+
+	// Cursor
+	func() {
+		fieldValues := values["cursor"]
+		if len(fieldValues) == 0 {
+			target.Cursor = nil
+			return
+		}
+
+		cast := func(value string) (string, bool) {
+			return value, true
+		}
+
+		value, _ := cast(fieldValues[0])
+		target.Cursor = &value
+	}()
+
+	if len(validationErrors.FieldErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// parseQueryIntoFilters parses query parameters into target the same way as
+// reqparse.ParseQuery with default options.
+func parseQueryIntoFilters(values map[string][]string, target *filters) error {
+	validationErrors := &reqparse.QueryValidationError{
+		Source:       reqparse.SourceQuery,
+		FieldErrors:  make(map[string][]string),
+		StructErrors: make([]string, 0),
+	}
+
+	// Color
+	func() {
+		fieldValues := values["color"]
+		if len(fieldValues) == 0 {
+			fieldValues = []string{"red"}
+		}
+
+		cast := func(value string) (string, bool) {
+			return value, true
+		}
+
+		value, _ := cast(fieldValues[0])
+		target.Color = value
+	}()
+
+	if len(validationErrors.FieldErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// Command reqparsegen generates reflection-free query parameter parsers for tagged structs.
+//
+// For each struct type given with the -type flag, a ParseQueryInto<Type> function is generated:
+//
+//	func ParseQueryIntoSearchParams(values map[string][]string, target *SearchParams) error
+//
+// The generated functions parse query parameters the same way as reqparse.ParseQuery with default
+// options and report the same validation errors, without using reflection. Fields of the struct
+// types must have the `query` tag and the types supported by the generator: string, int, float64,
+// bool, time.Time and time.Duration, and slices of and pointers to them. The default, required,
+// unique, stripsuffix, negate, booltokens, layout and durationunit tags are supported.
+//
+// reqparsegen is meant to be run by go generate:
+//
+//	//go:generate go run github.com/berk-karaal/reqparse/cmd/reqparsegen -type=SearchParams
+//
+// The types are looked up in the Go files of the current directory, and the functions are written
+// to <type>_reqparse.go, named after the first type, unless the -output flag is given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma separated list of struct type names; required")
+	output := flag.String("output", "", "output file name; default <type>_reqparse.go")
+	flag.Parse()
+
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2) //nolint:gomnd
+	}
+
+	if err := run(".", strings.Split(*typeNames, ","), *output); err != nil {
+		fmt.Fprintln(os.Stderr, "reqparsegen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the parsers of the types declared in the package in dir and writes them into the
+// output file in dir.
+func run(dir string, typeNames []string, output string) error {
+	if output == "" {
+		output = strings.ToLower(typeNames[0]) + "_reqparse.go"
+	}
+
+	src, err := generate(dir, typeNames, output)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, output), src, 0o644) //nolint:gosec,gomnd
+}
@@ -0,0 +1,62 @@
+package reqparse
+
+import "reflect"
+
+// QueryParser parses query parameters into values of the struct type T. It is created by
+// [CompileQuery], which analyzes the struct type once, so parsing doesn't look up the struct tags
+// or check the field types again. A QueryParser is safe for concurrent use.
+//
+// It is named QueryParser rather than Parser since [Parser] parses into any struct type.
+type QueryParser[T any] struct {
+	plan *structPlan
+	opts ParseQueryOptions
+}
+
+// CompileQuery analyzes the struct type T for parsing query parameters and returns a
+// [QueryParser] for it. The struct tags, field types, defaults and validation presets are checked
+// while compiling, so the errors [ParseQuery] returns for invalid struct definitions, e.g.
+// [ErrQueryTagNotFound] or [ErrInvalidQueryFieldType], are returned by CompileQuery instead of
+// the Parse method. T must be a struct type; otherwise [ErrInvalidQueryTarget] is returned.
+//
+// The options are copied, so modifying them after calling CompileQuery has no effect on the
+// returned parser. If options are nil, default options are used.
+func CompileQuery[T any](opts *ParseQueryOptions) (*QueryParser[T], error) {
+	p := &QueryParser[T]{}
+	if opts != nil {
+		p.opts = *opts
+	}
+
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		return nil, ErrInvalidQueryTarget
+	}
+
+	if err := checkOptions(&p.opts); err != nil {
+		return nil, err
+	}
+
+	plan, err := newStructPlan(structType, querySource, &p.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The plan is not cached, so the rules of the presets of the options are resolved once here
+	// instead of on every Parse.
+	if err := plan.resolvePresets(p.opts.Presets); err != nil {
+		return nil, err
+	}
+
+	p.plan = plan
+
+	return p, nil
+}
+
+// Parse parses query parameters into a new value of T. Binding works the same way as
+// [ParseQuery]; validation errors are reported with [QueryValidationError] type.
+func (p *QueryParser[T]) Parse(queryParams map[string][]string) (T, error) {
+	var target T
+
+	err := p.plan.bind(queryParams, reflect.ValueOf(&target), querySource, &p.opts)
+
+	return target, err
+}
@@ -0,0 +1,174 @@
+package reqparse_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page   int               `query:"page"   default:"1"`
+			Limit  *int              `query:"limit"`
+			Tags   []string          `query:"tag"    unique:"true"`
+			Filter map[string]string `query:"filter"`
+			Note   string            `query:"-"`
+		}
+
+		parser, err := reqparse.CompileQuery[MyStruct](nil)
+		require.NoError(t, err)
+
+		s, err := parser.Parse(map[string][]string{
+			"tag":           {"a", "b"},
+			"filter[color]": {"red"},
+			"note":          {"x"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Page:   1,
+			Tags:   []string{"a", "b"},
+			Filter: map[string]string{"color": "red"},
+		}, s)
+
+		s, err = parser.Parse(map[string][]string{"page": {"3"}, "limit": {"10"}})
+		require.NoError(t, err)
+		assert.Equal(t, 3, s.Page)
+		require.NotNil(t, s.Limit)
+		assert.Equal(t, 10, *s.Limit)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int      `query:"page"`
+			Tags []string `query:"tag"  unique:"true"`
+		}
+
+		parser, err := reqparse.CompileQuery[MyStruct](nil)
+		require.NoError(t, err)
+
+		_, err = parser.Parse(map[string][]string{"tag": {"a", "a"}})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page": {"field is required"},
+			"tag":  {"values must be unique"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Verbose bool `query:"verbose"`
+			Limit   int  `query:"limit"   preset:"limit"`
+		}
+
+		opts := &reqparse.ParseQueryOptions{
+			PresenceBools: true,
+			Presets:       map[string][]reqparse.Rule{"limit": {reqparse.Max(100)}},
+		}
+
+		parser, err := reqparse.CompileQuery[MyStruct](opts)
+		require.NoError(t, err)
+
+		// Modifying the options after compiling has no effect on the parser, and the presets are
+		// resolved while compiling.
+		opts.PresenceBools = false
+		opts.Presets["limit"] = nil
+
+		s, err := parser.Parse(map[string][]string{"verbose": {""}, "limit": {"100"}})
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Verbose: true, Limit: 100}, s)
+
+		_, err = parser.Parse(map[string][]string{"limit": {"101"}})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr.FieldErrors, "limit")
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int `query:"page"`
+		}
+
+		parser, err := reqparse.CompileQuery[MyStruct](nil)
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				s, err := parser.Parse(map[string][]string{"page": {"2"}})
+				assert.NoError(t, err)
+				assert.Equal(t, 2, s.Page)
+			}()
+		}
+
+		wg.Wait()
+	})
+
+	t.Run("invalid struct definitions", func(t *testing.T) {
+		t.Parallel()
+
+		type NoTag struct {
+			Page int
+		}
+
+		_, err := reqparse.CompileQuery[NoTag](nil)
+		require.ErrorIs(t, err, reqparse.ErrQueryTagNotFound)
+
+		type InvalidType struct {
+			Page complex128 `query:"page"`
+		}
+
+		_, err = reqparse.CompileQuery[InvalidType](nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+
+		type InvalidTag struct {
+			Timeout int `query:"timeout" durationunit:"days"`
+		}
+
+		_, err = reqparse.CompileQuery[InvalidTag](nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type UnknownPreset struct {
+			Limit int `query:"limit" preset:"unknown"`
+		}
+
+		_, err = reqparse.CompileQuery[UnknownPreset](nil)
+		require.ErrorIs(t, err, reqparse.ErrUnknownPreset)
+
+		type NestedUnknownPreset struct {
+			Filter UnknownPreset `query:"filter"`
+		}
+
+		_, err = reqparse.CompileQuery[NestedUnknownPreset](nil)
+		require.ErrorIs(t, err, reqparse.ErrUnknownPreset)
+
+		_, err = reqparse.CompileQuery[int](nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryTarget)
+
+		_, err = reqparse.CompileQuery[struct {
+			Page int `query:"page"`
+		}](&reqparse.ParseQueryOptions{MapKeyStyle: "colon"})
+		require.ErrorIs(t, err, reqparse.ErrInvalidOption)
+	})
+}
@@ -0,0 +1,200 @@
+package reqparse
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ETag is an entity tag of the conditional request headers.
+type ETag struct {
+	// Value is the opaque tag without the quotes, e.g. `xyzzy` for `W/"xyzzy"`.
+	Value string
+
+	// Weak reports whether the tag has the weakness indicator, e.g. `W/"xyzzy"`.
+	Weak bool
+}
+
+// String returns the entity tag in the header format, e.g. `W/"xyzzy"`.
+func (e ETag) String() string {
+	if e.Weak {
+		return `W/"` + e.Value + `"`
+	}
+
+	return `"` + e.Value + `"`
+}
+
+// StrongMatch reports whether both tags are strong and have the same value.
+func (e ETag) StrongMatch(other ETag) bool {
+	return !e.Weak && !other.Weak && e.Value == other.Value
+}
+
+// WeakMatch reports whether the tags have the same value regardless of their weakness.
+func (e ETag) WeakMatch(other ETag) bool {
+	return e.Value == other.Value
+}
+
+// Conditionals are the parsed conditional request headers.
+type Conditionals struct {
+	// IfMatch are the entity tags of the If-Match header. IfMatchAny is true if the header is "*".
+	IfMatch    []ETag
+	IfMatchAny bool
+
+	// IfNoneMatch are the entity tags of the If-None-Match header. IfNoneMatchAny is true if the
+	// header is "*".
+	IfNoneMatch    []ETag
+	IfNoneMatchAny bool
+
+	// IfModifiedSince and IfUnmodifiedSince are the dates of the headers, or nil if the headers are
+	// missing or they are not valid HTTP dates.
+	IfModifiedSince   *time.Time
+	IfUnmodifiedSince *time.Time
+}
+
+// IfMatchPasses reports whether the If-Match condition passes for the current entity tag of the
+// resource. exists reports whether the resource exists. The condition passes if the header is
+// missing, or it is "*" and the resource exists, or one of its tags strongly matches the current
+// tag.
+func (c Conditionals) IfMatchPasses(current ETag, exists bool) bool {
+	if c.IfMatchAny {
+		return exists
+	}
+
+	if c.IfMatch == nil {
+		return true
+	}
+
+	for _, tag := range c.IfMatch {
+		if exists && tag.StrongMatch(current) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IfNoneMatchPasses reports whether the If-None-Match condition passes for the current entity tag
+// of the resource. exists reports whether the resource exists. The condition passes if the header
+// is missing, or it is "*" and the resource doesn't exist, or none of its tags weakly matches the
+// current tag.
+func (c Conditionals) IfNoneMatchPasses(current ETag, exists bool) bool {
+	if c.IfNoneMatchAny {
+		return !exists
+	}
+
+	for _, tag := range c.IfNoneMatch {
+		if exists && tag.WeakMatch(current) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseConditionals parses the If-Match, If-None-Match, If-Modified-Since and If-Unmodified-Since
+// headers of the request. It returns [ErrInvalidETag] if an entity tag list is malformed. Invalid
+// dates are ignored as required by RFC 9110.
+func ParseConditionals(r *http.Request) (Conditionals, error) {
+	var (
+		conditionals Conditionals
+		err          error
+	)
+
+	if values := r.Header.Values("If-Match"); len(values) > 0 {
+		conditionals.IfMatch, conditionals.IfMatchAny, err = parseETagList(values)
+		if err != nil {
+			return Conditionals{}, err
+		}
+	}
+
+	if values := r.Header.Values("If-None-Match"); len(values) > 0 {
+		conditionals.IfNoneMatch, conditionals.IfNoneMatchAny, err = parseETagList(values)
+		if err != nil {
+			return Conditionals{}, err
+		}
+	}
+
+	conditionals.IfModifiedSince = parseHTTPDate(r.Header.Get("If-Modified-Since"))
+	conditionals.IfUnmodifiedSince = parseHTTPDate(r.Header.Get("If-Unmodified-Since"))
+
+	return conditionals, nil
+}
+
+// parseETagList parses the values of an entity tag list header. It reports whether the header is
+// "*" instead of a list.
+func parseETagList(values []string) ([]ETag, bool, error) {
+	value := strings.TrimSpace(strings.Join(values, ","))
+	if value == "*" {
+		return nil, true, nil
+	}
+
+	tags := make([]ETag, 0)
+
+	for {
+		value = strings.TrimLeft(value, " \t,")
+		if value == "" {
+			break
+		}
+
+		var tag ETag
+
+		if strings.HasPrefix(value, "W/") {
+			tag.Weak = true
+			value = value[2:]
+		}
+
+		if !strings.HasPrefix(value, `"`) {
+			return nil, false, ErrInvalidETag
+		}
+
+		end := strings.IndexByte(value[1:], '"')
+		if end < 0 {
+			return nil, false, ErrInvalidETag
+		}
+
+		tag.Value = value[1 : end+1]
+		if !isValidETagValue(tag.Value) {
+			return nil, false, ErrInvalidETag
+		}
+
+		tags = append(tags, tag)
+
+		value = strings.TrimLeft(value[end+2:], " \t")
+		if value != "" && value[0] != ',' {
+			return nil, false, ErrInvalidETag
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil, false, ErrInvalidETag
+	}
+
+	return tags, false, nil
+}
+
+// isValidETagValue reports whether the opaque tag contains only the characters allowed by RFC 9110.
+func isValidETagValue(value string) bool {
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c < 0x21 || c == 0x7f {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseHTTPDate parses the value in one of the HTTP date formats. It returns nil if the value is
+// empty or not a valid HTTP date.
+func parseHTTPDate(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,104 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConditionals(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(header map[string][]string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		for name, values := range header {
+			for _, value := range values {
+				r.Header.Add(name, value)
+			}
+		}
+
+		return r
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRequest(map[string][]string{
+			"If-Match":            {`"xyzzy", W/"r2d2,xxx"`, `"c3piozzzz"`},
+			"If-None-Match":       {"*"},
+			"If-Modified-Since":   {"Sun, 06 Nov 1994 08:49:37 GMT"},
+			"If-Unmodified-Since": {"not a date"},
+		})
+
+		conditionals, err := reqparse.ParseConditionals(r)
+
+		modifiedSince := time.Date(1994, 11, 6, 8, 49, 37, 0, time.UTC)
+
+		require.NoError(t, err)
+		assert.Equal(t, reqparse.Conditionals{
+			IfMatch: []reqparse.ETag{
+				{Value: "xyzzy"},
+				{Value: "r2d2,xxx", Weak: true},
+				{Value: "c3piozzzz"},
+			},
+			IfNoneMatchAny:  true,
+			IfModifiedSince: &modifiedSince,
+		}, conditionals)
+	})
+
+	t.Run("no headers", func(t *testing.T) {
+		t.Parallel()
+
+		conditionals, err := reqparse.ParseConditionals(newRequest(nil))
+
+		require.NoError(t, err)
+		assert.Equal(t, reqparse.Conditionals{}, conditionals)
+		assert.True(t, conditionals.IfMatchPasses(reqparse.ETag{Value: "a"}, true))
+		assert.True(t, conditionals.IfNoneMatchPasses(reqparse.ETag{Value: "a"}, true))
+	})
+
+	t.Run("invalid entity tags", func(t *testing.T) {
+		t.Parallel()
+
+		for _, value := range []string{`xyzzy`, `"xyzzy`, `"a" "b"`, `*, "a"`, `,`, `"a b"`} {
+			r := newRequest(map[string][]string{"If-None-Match": {value}})
+
+			_, err := reqparse.ParseConditionals(r)
+
+			require.ErrorIs(t, err, reqparse.ErrInvalidETag, value)
+		}
+	})
+
+	t.Run("conditions", func(t *testing.T) {
+		t.Parallel()
+
+		current := reqparse.ETag{Value: "v2"}
+		weakCurrent := reqparse.ETag{Value: "v2", Weak: true}
+
+		conditionals := reqparse.Conditionals{
+			IfMatch:     []reqparse.ETag{{Value: "v1"}, {Value: "v2"}},
+			IfNoneMatch: []reqparse.ETag{{Value: "v2", Weak: true}},
+		}
+
+		assert.True(t, conditionals.IfMatchPasses(current, true))
+		assert.False(t, conditionals.IfMatchPasses(weakCurrent, true))
+		assert.False(t, conditionals.IfMatchPasses(current, false))
+		assert.False(t, conditionals.IfNoneMatchPasses(current, true))
+		assert.True(t, conditionals.IfNoneMatchPasses(reqparse.ETag{Value: "v3"}, true))
+		assert.True(t, conditionals.IfNoneMatchPasses(current, false))
+
+		wildcard := reqparse.Conditionals{IfMatchAny: true, IfNoneMatchAny: true}
+		assert.True(t, wildcard.IfMatchPasses(current, true))
+		assert.False(t, wildcard.IfMatchPasses(current, false))
+		assert.False(t, wildcard.IfNoneMatchPasses(current, true))
+		assert.True(t, wildcard.IfNoneMatchPasses(current, false))
+
+		assert.Equal(t, `W/"v2"`, weakCurrent.String())
+		assert.Equal(t, `"v2"`, current.String())
+	})
+}
@@ -0,0 +1,71 @@
+package reqparse
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Converter converts a single value into a value of a custom type, e.g. decimal.Decimal. Create
+// converters with [NewConverter] and pass them with the [ParseQueryOptions.Converters] option, or
+// register them for all parsers with [RegisterConverter].
+type Converter struct {
+	targetType reflect.Type
+	convert    func(value string) (reflect.Value, error)
+}
+
+// NewConverter creates a [Converter] for the type T. Fields of type T, and their slice and pointer
+// variants, are casted with convert. If convert returns an error, its message is reported as the
+// validation error of the field.
+//
+// Converters take precedence over the built-in casting rules of the package, so they can also be
+// used for changing how a supported type, e.g. bool, is casted.
+func NewConverter[T any](convert func(value string) (T, error)) Converter {
+	return Converter{
+		targetType: reflect.TypeOf((*T)(nil)).Elem(),
+		convert: func(value string) (reflect.Value, error) {
+			v, err := convert(value)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			return reflect.ValueOf(&v).Elem(), nil
+		},
+	}
+}
+
+// registeredConverters holds the converters registered with [RegisterConverter]. Keys are the
+// target types and values are [Converter] values.
+var registeredConverters sync.Map //nolint:gochecknoglobals
+
+// RegisterConverter registers a converter for the type T used by all parsers. See [NewConverter]
+// for details. Converters passed with the [ParseQueryOptions.Converters] option take precedence
+// over the registered ones. Registering a converter for a type replaces the previous one.
+//
+// RegisterConverter is meant to be called during program initialization, e.g. in an init
+// function. It is safe for concurrent use.
+func RegisterConverter[T any](convert func(value string) (T, error)) {
+	converter := NewConverter(convert)
+	registeredConverters.Store(converter.targetType, converter)
+
+	// Cached plans may have checked the field types without the new converter.
+	structPlans.Range(func(key, _ any) bool {
+		structPlans.Delete(key)
+		return true
+	})
+}
+
+// lookupConverter returns the converter of the type. The converters are searched first, then the
+// registered converters.
+func lookupConverter(t reflect.Type, converters []Converter) (Converter, bool) {
+	for _, converter := range converters {
+		if converter.targetType == t {
+			return converter, true
+		}
+	}
+
+	if converter, ok := registeredConverters.Load(t); ok {
+		return converter.(Converter), true //nolint:forcetypeassert
+	}
+
+	return Converter{}, false
+}
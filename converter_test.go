@@ -0,0 +1,157 @@
+package reqparse_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cents is an amount of money parsed from decimal strings, e.g. "12.34".
+type cents struct {
+	value int64
+}
+
+func parseCents(value string) (cents, error) {
+	whole, fraction, found := strings.Cut(value, ".")
+	if found && len(fraction) != 2 {
+		return cents{}, errors.New("must be a valid amount")
+	}
+
+	n, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return cents{}, errors.New("must be a valid amount")
+	}
+
+	if !found {
+		n *= 100
+	}
+
+	return cents{value: n}, nil
+}
+
+// accountID is a type whose converter is registered in TestRegisterConverter.
+type accountID struct {
+	id string
+}
+
+func TestConverters(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Price   cents   `query:"price"`
+		Prices  []cents `query:"prices"`
+		Limit   *cents  `query:"limit"   default:"10"`
+		Enabled bool    `query:"enabled"`
+	}
+
+	opts := &reqparse.ParseQueryOptions{
+		Converters: []reqparse.Converter{
+			reqparse.NewConverter(parseCents),
+			reqparse.NewConverter(func(value string) (bool, error) {
+				return value == "yes", nil
+			}),
+		},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"price":   {"12.34"},
+			"prices":  {"1", "2.50"},
+			"enabled": {"yes"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, opts)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Price:   cents{1234},
+			Prices:  []cents{{100}, {250}},
+			Limit:   &cents{1000},
+			Enabled: true,
+		}, s)
+	})
+
+	t.Run("converter errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"price":   {"12.3"},
+			"prices":  {"1", "x"},
+			"enabled": {"no"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, opts)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"price":  {"must be a valid amount"},
+			"prices": {"(Index: 1) must be a valid amount"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("type without converter", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+	})
+}
+
+func TestRegisterConverter(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Account  accountID   `query:"account"`
+		Accounts []accountID `query:"accounts"`
+	}
+
+	inputQueryParams := map[string][]string{
+		"account":  {"acc_1"},
+		"accounts": {"acc_2", "3"},
+	}
+
+	var s MyStruct
+	err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+	require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+
+	reqparse.RegisterConverter(func(value string) (accountID, error) {
+		if !strings.HasPrefix(value, "acc_") {
+			return accountID{}, errors.New("must be a valid account ID")
+		}
+
+		return accountID{id: value}, nil
+	})
+
+	err = reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+	var validationError *reqparse.QueryValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Equal(t, map[string][]string{
+		"accounts": {"(Index: 1) must be a valid account ID"},
+	}, validationError.FieldErrors)
+	assert.Equal(t, accountID{id: "acc_1"}, s.Account)
+
+	// Converters of the options take precedence over the registered ones.
+	s = MyStruct{}
+	err = reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+		Converters: []reqparse.Converter{
+			reqparse.NewConverter(func(value string) (accountID, error) {
+				return accountID{id: value}, nil
+			}),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []accountID{{id: "acc_2"}, {id: "3"}}, s.Accounts)
+}
@@ -0,0 +1,370 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// crossFieldConstraint is a constraint of the `validate` tag comparing the value of the field
+// with the value of another field of the same struct, e.g. "gtfield=MinPrice".
+type crossFieldConstraint struct {
+	code ErrorCode
+
+	// relation describes the expected relation in the error message, e.g. "greater than".
+	relation string
+
+	// holds reports whether the constraint holds for the result of [compareFieldValues].
+	holds func(cmp int) bool
+}
+
+// crossFieldConstraints are the cross-field constraints of the `validate` tag comparing the
+// values of the fields, keyed by their names.
+var crossFieldConstraints = map[string]crossFieldConstraint{ //nolint:gochecknoglobals
+	"gtfield": {
+		code:     CodeGtField,
+		relation: "greater than",
+		holds:    func(cmp int) bool { return cmp > 0 },
+	},
+	"gtefield": {
+		code:     CodeGteField,
+		relation: "greater than or equal to",
+		holds:    func(cmp int) bool { return cmp >= 0 },
+	},
+	"ltfield": {
+		code:     CodeLtField,
+		relation: "less than",
+		holds:    func(cmp int) bool { return cmp < 0 },
+	},
+	"ltefield": {
+		code:     CodeLteField,
+		relation: "less than or equal to",
+		holds:    func(cmp int) bool { return cmp <= 0 },
+	},
+}
+
+// fieldRef refers to a field of the target struct or its nested structs.
+type fieldRef struct {
+	// index and names are the index and Go field name paths of the field from the target struct.
+	index []int
+	names []string
+}
+
+// field returns the reference of the field at the index path of the struct type, which is the
+// type of the field referenced by r, e.g. a promoted field of an embedded struct.
+func (r fieldRef) field(structType reflect.Type, index []int) fieldRef {
+	return fieldRef{
+		index: append(r.index[:len(r.index):len(r.index)], index...),
+		names: append(r.names[:len(r.names):len(r.names)], fieldNamesOf(structType, index)...),
+	}
+}
+
+// crossFieldRule is a cross-field constraint of a field.
+type crossFieldRule struct {
+	constraint crossFieldConstraint
+	field      fieldRef
+
+	// other is the field compared with.
+	other fieldRef
+}
+
+// crossFieldRules are the rules of a struct type involving multiple fields.
+type crossFieldRules struct {
+	comparisons  []crossFieldRule
+	requirements []requiredRule
+}
+
+// crossFieldRulesCache caches the cross-field rules of the struct types. Keys are struct types
+// and values are *crossFieldRules.
+var crossFieldRulesCache sync.Map //nolint:gochecknoglobals
+
+// cachedCrossFieldRules returns the cross-field rules of the struct type. Rules are collected once
+// per struct type and reused by the later calls.
+func cachedCrossFieldRules(structType reflect.Type) (*crossFieldRules, error) {
+	if rules, ok := crossFieldRulesCache.Load(structType); ok {
+		return rules.(*crossFieldRules), nil //nolint:forcetypeassert
+	}
+
+	rules := &crossFieldRules{}
+
+	err := rules.collect(structType, fieldRef{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	crossFieldRulesCache.Store(structType, rules)
+
+	return rules, nil
+}
+
+// collect adds the cross-field rules of the fields of the struct type and its nested structs.
+// parent refers to the struct from the target struct, and parents are the struct types containing
+// it, which are used to stop at recursive struct types. Invalid constraints cause [ErrInvalidTag]
+// error.
+func (r *crossFieldRules) collect(
+	structType reflect.Type,
+	parent fieldRef,
+	parents []reflect.Type,
+) error {
+	parents = append(parents[:len(parents):len(parents)], structType)
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		field := parent.field(structType, []int{i})
+
+		for _, constraint := range splitValidateTag(structField.Tag.Get("validate")) {
+			name, arg, _ := strings.Cut(constraint, "=")
+
+			var ok bool
+
+			switch name {
+			case "gtfield", "gtefield", "ltfield", "ltefield":
+				ok = r.addComparison(structType, structField, parent, field, name, arg)
+			case "required_if", "required_without":
+				ok = r.addRequirement(structType, structField, parent, field, name, arg)
+			default:
+				continue
+			}
+
+			if !ok {
+				return fmt.Errorf(
+					"%w: validate:%q (%s)", ErrInvalidTag, constraint, structField.Name,
+				)
+			}
+		}
+
+		nestedType := structField.Type
+		if nestedType.Kind() == reflect.Pointer {
+			nestedType = nestedType.Elem()
+		}
+
+		if nestedType.Kind() != reflect.Struct || nestedType == timeType ||
+			(!structField.IsExported() && !structField.Anonymous) ||
+			containsType(parents, nestedType) {
+			continue
+		}
+
+		if err := r.collect(nestedType, field, parents); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addComparison adds the rule of the cross-field constraint comparing the field with the field of
+// the same struct named by arg, e.g. "gtfield=MinPrice". The fields must have the same numeric,
+// string or [time.Time] type, ignoring pointers. It returns false if the constraint is invalid.
+func (r *crossFieldRules) addComparison(
+	structType reflect.Type,
+	structField reflect.StructField,
+	parent fieldRef,
+	field fieldRef,
+	name string,
+	arg string,
+) bool {
+	other, ok := structType.FieldByName(arg)
+	if !ok || arg == structField.Name || !isComparableFieldPair(structField, other) {
+		return false
+	}
+
+	r.comparisons = append(r.comparisons, crossFieldRule{
+		constraint: crossFieldConstraints[name],
+		field:      field,
+		other:      parent.field(structType, other.Index),
+	})
+
+	return true
+}
+
+// isComparableFieldPair reports whether the values of the fields can be compared by the
+// cross-field constraints. Both fields must be exported.
+func isComparableFieldPair(structField, other reflect.StructField) bool {
+	if !structField.IsExported() || !other.IsExported() {
+		return false
+	}
+
+	fieldType, otherType := structField.Type, other.Type
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	if otherType.Kind() == reflect.Pointer {
+		otherType = otherType.Elem()
+	}
+
+	if fieldType != otherType {
+		return false
+	}
+
+	switch fieldType.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return true
+	default:
+		return fieldType == timeType
+	}
+}
+
+// fieldNamesOf returns the Go field names of the index path in the struct type, e.g. the names of
+// the embedded structs and the field for a promoted field.
+func fieldNamesOf(structType reflect.Type, index []int) []string {
+	names := make([]string, 0, len(index))
+
+	for _, i := range index {
+		if structType.Kind() == reflect.Pointer {
+			structType = structType.Elem()
+		}
+
+		names = append(names, structType.Field(i).Name)
+		structType = structType.Field(i).Type
+	}
+
+	return names
+}
+
+// containsType reports whether types contains t.
+func containsType(types []reflect.Type, t reflect.Type) bool {
+	for _, typ := range types {
+		if typ == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// runCrossFieldRules checks the cross-field rules of the struct. Violated comparisons are reported
+// as struct errors naming the keys of both fields, e.g. "max_price must be greater than
+// min_price", and are skipped if either field has a validation error or has no value, e.g. a nil
+// pointer. See [requiredRule] for the conditional requirements.
+func runCrossFieldRules(
+	structElem reflect.Value,
+	fieldKey fieldKeyFunc,
+	validationErrors *QueryValidationError,
+) error {
+	rules, err := cachedCrossFieldRules(structElem.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules.requirements {
+		rule.check(structElem, fieldKey, validationErrors)
+	}
+
+	for _, rule := range rules.comparisons {
+		fieldv, ok := fieldValueAt(structElem, rule.field.index)
+		if !ok {
+			continue
+		}
+
+		otherv, ok := fieldValueAt(structElem, rule.other.index)
+		if !ok {
+			continue
+		}
+
+		key := crossFieldKey(fieldKey, rule.field.names)
+		otherKey := crossFieldKey(fieldKey, rule.other.names)
+
+		if len(validationErrors.FieldErrors[key]) > 0 ||
+			len(validationErrors.FieldErrors[otherKey]) > 0 {
+			continue
+		}
+
+		// Fields that are not supplied have their zero values, so they are compared only if one of
+		// them is supplied.
+		if !hasValue(structElem, rule.field.index, key, validationErrors) &&
+			!hasValue(structElem, rule.other.index, otherKey, validationErrors) {
+			continue
+		}
+
+		if !rule.constraint.holds(compareFieldValues(fieldv, otherv)) {
+			validationErrors.addStructErr(newCodedError(
+				rule.constraint.code,
+				key+" must be "+rule.constraint.relation+" "+otherKey,
+				map[string]any{"field": key, "other": otherKey},
+			))
+		}
+	}
+
+	return nil
+}
+
+// crossFieldKey returns the key of the field at the path of the Go field names, or the Go field
+// name if the field isn't bound, e.g. a field tagged with `query:"-"`.
+func crossFieldKey(fieldKey fieldKeyFunc, names []string) string {
+	if key, ok := fieldKey(names); ok {
+		return key
+	}
+
+	return names[len(names)-1]
+}
+
+// fieldValueAt returns the value of the field at the index path of the struct, dereferencing the
+// pointers. It returns false if a pointer on the path or the field is nil.
+func fieldValueAt(structv reflect.Value, index []int) (reflect.Value, bool) {
+	v := structv
+
+	for _, i := range index {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			v = v.Elem()
+		}
+
+		v = v.Field(i)
+	}
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		v = v.Elem()
+	}
+
+	return v, true
+}
+
+// compareFieldValues compares the values of the same type accepted by [isComparableFieldPair]. It
+// returns -1 if a is less than b, 1 if a is greater than b and 0 otherwise.
+func compareFieldValues(a, b reflect.Value) int {
+	switch a.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareNumbers(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareNumbers(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareNumbers(a.Float(), b.Float())
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	default:
+		aTime, _ := a.Interface().(time.Time)
+		bTime, _ := b.Interface().(time.Time)
+
+		return compareOrdered(aTime.Before(bTime), aTime.After(bTime))
+	}
+}
+
+// compareNumbers returns -1 if a is less than b, 1 if a is greater than b and 0 otherwise.
+func compareNumbers[T int64 | uint64 | float64](a, b T) int {
+	return compareOrdered(a < b, a > b)
+}
+
+// compareOrdered returns the comparison result for the results of the less and greater
+// comparisons.
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,168 @@
+package reqparse_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryCrossFieldRules(t *testing.T) {
+	t.Parallel()
+
+	type Period struct {
+		Start time.Time  `query:"start" timeformat:"date"`
+		End   *time.Time `query:"end"   timeformat:"date" validate:"gtefield=Start"`
+	}
+
+	type MyStruct struct {
+		MinPrice *float64 `query:"min_price"`
+		MaxPrice *float64 `query:"max_price" validate:"min=0,gtfield=MinPrice"`
+		From     int      `query:"from"      validate:"ltefield=To"                default:"0"`
+		To       int      `query:"to"                                             default:"100"`
+		Period   *Period  `query:"period"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"min_price":    {"10"},
+			"max_price":    {"20.5"},
+			"from":         {"100"},
+			"period.start": {"2024-01-01"},
+			"period.end":   {"2024-01-01"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, 20.5, *s.MaxPrice)
+		assert.Equal(t, 100, s.From)
+	})
+
+	t.Run("absent values", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"max_price":    {"5"},
+			"period.start": {"2024-01-01"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("neither value supplied", func(t *testing.T) {
+		t.Parallel()
+
+		type Range struct {
+			Min int `query:"min" default:"0"`
+			Max int `query:"max" default:"0" validate:"gtfield=Min"`
+		}
+
+		var s Range
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.NoError(t, err)
+
+		err = reqparse.ParseQuery(map[string][]string{"min": {"0"}}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"max must be greater than min"}, validationErr.StructErrors)
+	})
+
+	t.Run("struct errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"min_price":    {"10"},
+			"max_price":    {"10"},
+			"from":         {"101"},
+			"period.start": {"2024-01-02"},
+			"period.end":   {"2024-01-01"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			"max_price must be greater than min_price",
+			"from must be less than or equal to to",
+			"period.end must be greater than or equal to period.start",
+		}, validationErr.StructErrors)
+		assert.Empty(t, validationErr.FieldErrors)
+	})
+
+	t.Run("fields with errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"min_price": {"x"},
+			"max_price": {"-1"},
+			"from":      {"101"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			"from must be less than or equal to to",
+		}, validationErr.StructErrors)
+		assert.Equal(t, map[string][]string{
+			"min_price": {"must be a valid float"},
+			"max_price": {"must be greater than or equal to 0"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid validate tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				To int `query:"to" validate:"gtfield=From"`
+			}{},
+			&struct {
+				From string `query:"from"`
+				To   int    `query:"to" validate:"gtfield=From"`
+			}{},
+			&struct {
+				From []int `query:"from"`
+				To   []int `query:"to" validate:"gtfield=From"`
+			}{},
+			&struct {
+				To int `query:"to" validate:"gtfield="`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{"to": {"1"}}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
+
+func TestParseJSONCrossFieldRules(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Min int `json:"min"`
+		Max int `json:"max" validate:"gtfield=Min"`
+	}
+
+	var s MyStruct
+	err := reqparse.ParseJSON(strings.NewReader(`{"min": 5, "max": 3}`), &s, nil)
+
+	var validationErr *reqparse.QueryValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []string{"max must be greater than min"}, validationErr.StructErrors)
+}
@@ -0,0 +1,168 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Separators accepted by the `decimalsep` and `groupsep` tags and the DecimalSeparator option.
+const (
+	decimalSeparators = ".,"
+	groupSeparators   = ".,' _"
+)
+
+// digitGroupSize is the number of the digits between the group separators.
+const digitGroupSize = 3
+
+// checkDecimalSeparator returns an error if the DecimalSeparator option is invalid.
+func checkDecimalSeparator(opts *ParseQueryOptions) error {
+	if opts.DecimalSeparator != "" && !isSeparator(opts.DecimalSeparator, decimalSeparators) {
+		return fmt.Errorf("%w: DecimalSeparator %q", ErrInvalidOption, opts.DecimalSeparator)
+	}
+
+	return nil
+}
+
+// isSeparator reports whether sep is one of the single character separators.
+func isSeparator(sep string, separators string) bool {
+	return len(sep) == 1 && strings.Contains(separators, sep)
+}
+
+// setNumberSeparators sets the decimal and group separators of the float values from the
+// `decimalsep` and `groupsep` tags of the field, or the DecimalSeparator option. The tags are
+// allowed only on float fields and slices, pointers and maps of them, and the separators must be
+// different.
+func (o *castOptions) setNumberSeparators(
+	structField reflect.StructField,
+	decimalSeparator string,
+	converters []Converter,
+) error {
+	decimalTag, hasDecimalTag := structField.Tag.Lookup("decimalsep")
+	groupTag, hasGroupTag := structField.Tag.Lookup("groupsep")
+
+	if !hasDecimalTag && !hasGroupTag {
+		if decimalSeparator != "." {
+			o.decimalSep = decimalSeparator
+		}
+
+		return nil
+	}
+
+	isFloat := isFloatField(structField.Type, converters)
+
+	if hasDecimalTag {
+		if !isFloat || !isSeparator(decimalTag, decimalSeparators) {
+			return fmt.Errorf(
+				"%w: decimalsep:%q (%s)", ErrInvalidTag, decimalTag, structField.Name,
+			)
+		}
+
+		decimalSeparator = decimalTag
+	}
+
+	if decimalSeparator == "" {
+		decimalSeparator = "."
+	}
+
+	if hasGroupTag {
+		if !isFloat || !isSeparator(groupTag, groupSeparators) || groupTag == decimalSeparator {
+			return fmt.Errorf("%w: groupsep:%q (%s)", ErrInvalidTag, groupTag, structField.Name)
+		}
+
+		o.groupSep = groupTag
+	}
+
+	if decimalSeparator != "." || o.groupSep != "" {
+		o.decimalSep = decimalSeparator
+	}
+
+	return nil
+}
+
+// isFloatField reports whether the field is a float64 field or a slice, pointer or map of float64
+// elements. Types with a converter or implementing [encoding.TextUnmarshaler] are not float fields
+// even if their kinds are float kinds.
+func isFloatField(fieldType reflect.Type, converters []Converter) bool {
+	if bindingKind(fieldType, converters) == reflect.Map {
+		fieldType = fieldType.Elem()
+	}
+
+	fieldType = fieldValueType(fieldType, converters)
+
+	if _, ok := lookupConverter(fieldType, converters); ok {
+		return false
+	}
+
+	return fieldType.Kind() == reflect.Float64 && !isTextUnmarshalerType(fieldType)
+}
+
+// normalizeFloat converts the float value written with the decimal and group separators of the
+// options into the format accepted by [strconv.ParseFloat], e.g. "1.234,5" into "1234.5" for the
+// "," decimal separator and "." group separator. Group separators are allowed only between the
+// groups of three digits of the integer part. It returns false if the value uses the separators
+// incorrectly.
+func normalizeFloat(value string, opts castOptions) (string, bool) {
+	integerPart, fractionPart, hasFraction := strings.Cut(value, opts.decimalSep)
+
+	// The other separator is valid only as the group separator.
+	otherSep := "."
+	if opts.decimalSep == "." {
+		otherSep = ","
+	}
+
+	if strings.Contains(fractionPart, opts.decimalSep) ||
+		(otherSep != opts.groupSep && strings.Contains(value, otherSep)) {
+		return "", false
+	}
+
+	if opts.groupSep != "" {
+		if strings.Contains(fractionPart, opts.groupSep) {
+			return "", false
+		}
+
+		var ok bool
+		if integerPart, ok = removeGroupSeparators(integerPart, opts.groupSep); !ok {
+			return "", false
+		}
+	}
+
+	if !hasFraction {
+		return integerPart, true
+	}
+
+	return integerPart + "." + fractionPart, true
+}
+
+// removeGroupSeparators removes the group separators from the integer part of a number, e.g.
+// "-1.234" for the "." group separator. The groups after the first one must have three digits.
+func removeGroupSeparators(integerPart string, groupSep string) (string, bool) {
+	groups := strings.Split(integerPart, groupSep)
+	if len(groups) == 1 {
+		return integerPart, true
+	}
+
+	first := strings.TrimLeft(groups[0], "+-")
+	if first == "" || len(first) > digitGroupSize || !isDigits(first) {
+		return "", false
+	}
+
+	for _, group := range groups[1:] {
+		if len(group) != digitGroupSize || !isDigits(group) {
+			return "", false
+		}
+	}
+
+	return strings.Join(groups, ""), true
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,138 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryDecimalSeparators(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Weight  float64            `query:"weight"  decimalsep:","`
+		Price   *float64           `query:"price"   decimalsep:"," groupsep:"."`
+		Amounts []float64          `query:"amount"  groupsep:","`
+		Swiss   float64            `query:"swiss"   groupsep:"'"`
+		Rates   map[string]float64 `query:"rates"   decimalsep:","`
+		Plain   float64            `query:"plain"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"weight":     {"70,5"},
+			"price":      {"-1.234.567,89"},
+			"amount":     {"1,234.5", "999", "12,345"},
+			"swiss":      {"1'000'000.25"},
+			"rates[eur]": {"0,91"},
+			"plain":      {"1.5"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		price := -1234567.89
+		assert.Equal(t, MyStruct{
+			Weight:  70.5,
+			Price:   &price,
+			Amounts: []float64{1234.5, 999, 12345},
+			Swiss:   1000000.25,
+			Rates:   map[string]float64{"eur": 0.91},
+			Plain:   1.5,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"weight": {"70.5"},
+			"price":  {"1.23,4"},
+			"amount": {"1,23", "1234,567", "1.234,5", ",123"},
+			"swiss":  {"1'000.2'5"},
+			"plain":  {"1,5"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"weight": {"must be a valid float"},
+			"price":  {"must be a valid float"},
+			"amount": {
+				"(Index: 0) must be a valid float",
+				"(Index: 1) must be a valid float",
+				"(Index: 2) must be a valid float",
+				"(Index: 3) must be a valid float",
+			},
+			"swiss": {"must be a valid float"},
+			"plain": {"must be a valid float"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("option", func(t *testing.T) {
+		t.Parallel()
+
+		type OptionStruct struct {
+			Weight float64 `query:"weight"`
+			Price  float64 `query:"price"  decimalsep:"."`
+			Count  int     `query:"count"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"weight": {"70,5"},
+			"price":  {"9.99"},
+			"count":  {"3"},
+		}
+
+		var s OptionStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{DecimalSeparator: ","},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, OptionStruct{Weight: 70.5, Price: 9.99, Count: 3}, s)
+	})
+
+	t.Run("invalid separators", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{},
+			&s,
+			&reqparse.ParseQueryOptions{DecimalSeparator: ";"},
+		)
+		require.ErrorIs(t, err, reqparse.ErrInvalidOption)
+
+		type SameSeparators struct {
+			Price float64 `query:"price" decimalsep:"," groupsep:","`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &SameSeparators{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type UnknownSeparator struct {
+			Price float64 `query:"price" decimalsep:";"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &UnknownSeparator{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type NotFloat struct {
+			Count int `query:"count" groupsep:","`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &NotFloat{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
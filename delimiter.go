@@ -0,0 +1,112 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Values of the `style` tag for slice fields, which select the serialization styles of the OpenAPI
+// array parameters.
+const (
+	// styleForm joins the elements with commas if the field is not exploded, e.g. `?ids=1,2,3`.
+	styleForm = "form"
+
+	// styleSpaceDelimited joins the elements with spaces, e.g. `?ids=1%202%203`.
+	styleSpaceDelimited = "spaceDelimited"
+
+	// stylePipeDelimited joins the elements with pipes, e.g. `?ids=1|2|3`.
+	stylePipeDelimited = "pipeDelimited"
+)
+
+// styleDelimiters are the delimiters of the values of the slice fields that are not exploded,
+// keyed by the `style` tag. Exploded fields are bound from repeated keys, e.g. `?ids=1&ids=2`.
+var styleDelimiters = map[string]string{ //nolint:gochecknoglobals
+	styleForm:           ",",
+	styleSpaceDelimited: " ",
+	stylePipeDelimited:  "|",
+}
+
+// delimiterFromTags returns the delimiter that splits each value of a slice field into multiple
+// elements, e.g. `?ids=1,2,3`. It is read from the `delimiter` tag, or selected by the `style` and
+// `explode` tags the same way as the OpenAPI array parameters: the form style is exploded by
+// default, and the spaceDelimited and pipeDelimited styles are not. It is empty if the values are
+// not split. isSlice reports whether the field is a slice or pointer to slice field, which are the
+// only fields these tags are allowed on. The deepObject style is checked by the callers.
+func delimiterFromTags(structField reflect.StructField, isSlice bool) (string, error) {
+	style, hasStyle := structField.Tag.Lookup("style")
+	explodeTag, hasExplode := structField.Tag.Lookup("explode")
+
+	if delimiter, ok := structField.Tag.Lookup("delimiter"); ok {
+		if delimiter == "" || !isSlice || hasStyle || hasExplode {
+			return "", fmt.Errorf(
+				"%w: delimiter:%q (%s)", ErrInvalidTag, delimiter, structField.Name,
+			)
+		}
+
+		return delimiter, nil
+	}
+
+	if style == styleDeepObject {
+		// deepObject style is always exploded.
+		if hasExplode && explodeTag != "true" {
+			return "", fmt.Errorf(
+				"%w: explode:%q (%s)", ErrInvalidTag, explodeTag, structField.Name,
+			)
+		}
+
+		return "", nil
+	}
+
+	if !hasStyle && !hasExplode {
+		return "", nil
+	}
+
+	if !hasStyle {
+		if !isSlice {
+			return "", fmt.Errorf(
+				"%w: explode:%q (%s)", ErrInvalidTag, explodeTag, structField.Name,
+			)
+		}
+
+		style = styleForm
+	}
+
+	delimiter, ok := styleDelimiters[style]
+	if !ok || !isSlice {
+		return "", fmt.Errorf("%w: style:%q (%s)", ErrInvalidTag, style, structField.Name)
+	}
+
+	explode := style == styleForm
+
+	if hasExplode {
+		switch explodeTag {
+		case "true":
+			explode = true
+		case "false":
+			explode = false
+		default:
+			return "", fmt.Errorf(
+				"%w: explode:%q (%s)", ErrInvalidTag, explodeTag, structField.Name,
+			)
+		}
+	}
+
+	if explode {
+		return "", nil
+	}
+
+	return delimiter, nil
+}
+
+// splitDelimitedValues returns the elements of the values split by the delimiter, in order. The
+// elements of repeated keys are concatenated, e.g. ["1,2", "3"] is split into ["1", "2", "3"].
+func splitDelimitedValues(values []string, delimiter string) []string {
+	elements := make([]string, 0, len(values))
+
+	for _, value := range values {
+		elements = append(elements, strings.Split(value, delimiter)...)
+	}
+
+	return elements
+}
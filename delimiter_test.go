@@ -0,0 +1,180 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryDelimitedValues(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		IDs    []int     `query:"ids"    delimiter:","`
+		Tags   *[]string `query:"tags"   delimiter:"|"`
+		Sizes  []int     `query:"sizes"  delimiter:";" default:"1;2"`
+		Colors []string  `query:"colors"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"ids":    {"1,2", "3"},
+			"tags":   {"a|b,c"},
+			"colors": {"red,blue"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			IDs:    []int{1, 2, 3},
+			Tags:   &[]string{"a", "b,c"},
+			Sizes:  []int{1, 2},
+			Colors: []string{"red,blue"},
+		}, s)
+	})
+
+	t.Run("indexed array keys", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"ids[0]": {"1,2"}, "ids[1]": {"3"}}, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{1, 2, 3}, s.IDs)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"ids":   {"1,x,", "y"},
+			"sizes": {""},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"ids": {
+				"(Index: 1) must be a valid integer",
+				"(Index: 2) must be a valid integer",
+				"(Index: 3) must be a valid integer",
+			},
+			"sizes": {"(Index: 0) must be a valid integer"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid delimiter tag", func(t *testing.T) {
+		t.Parallel()
+
+		type ScalarField struct {
+			ID int `query:"id" delimiter:","`
+		}
+
+		err := reqparse.ParseQuery(map[string][]string{}, &ScalarField{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type EmptyDelimiter struct {
+			IDs []int `query:"ids" delimiter:""`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &EmptyDelimiter{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
+
+func TestParseQuerySerializationStyles(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Form         []int          `query:"form"          style:"form"`
+		FormPacked   []int          `query:"form_packed"   style:"form"           explode:"false"`
+		Packed       []int          `query:"packed"                               explode:"false"`
+		Space        []string       `query:"space"         style:"spaceDelimited"`
+		Pipe         *[]string      `query:"pipe"          style:"pipeDelimited"`
+		PipeExploded []string       `query:"pipe_exploded" style:"pipeDelimited"  explode:"true"`
+		Limits       map[string]int `query:"limits"        style:"deepObject"     explode:"true"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"form":          {"1", "2"},
+			"form_packed":   {"1,2"},
+			"packed":        {"3,4"},
+			"space":         {"a b"},
+			"pipe":          {"a|b"},
+			"pipe_exploded": {"a|b", "c"},
+			"limits[users]": {"10"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Form:         []int{1, 2},
+			FormPacked:   []int{1, 2},
+			Packed:       []int{3, 4},
+			Space:        []string{"a", "b"},
+			Pipe:         &[]string{"a", "b"},
+			PipeExploded: []string{"a|b", "c"},
+			Limits:       map[string]int{"users": 10},
+		}, s)
+	})
+
+	t.Run("invalid tags", func(t *testing.T) {
+		t.Parallel()
+
+		type UnknownStyle struct {
+			IDs []int `query:"ids" style:"matrix"`
+		}
+
+		err := reqparse.ParseQuery(map[string][]string{}, &UnknownStyle{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type InvalidExplode struct {
+			IDs []int `query:"ids" style:"form" explode:"no"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &InvalidExplode{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type ScalarStyle struct {
+			ID int `query:"id" style:"pipeDelimited"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &ScalarStyle{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type ScalarExplode struct {
+			ID int `query:"id" explode:"false"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &ScalarExplode{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type StyleAndDelimiter struct {
+			IDs []int `query:"ids" style:"form" delimiter:";"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &StyleAndDelimiter{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type DeepObjectNotExploded struct {
+			Limits map[string]int `query:"limits" style:"deepObject" explode:"false"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &DeepObjectNotExploded{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
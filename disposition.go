@@ -0,0 +1,74 @@
+package reqparse
+
+import (
+	"mime"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ContentDisposition is the parsed value of a Content-Disposition header, e.g. the header of a
+// multipart form part or of a downloaded file.
+type ContentDisposition struct {
+	// Type is the disposition type, lower cased, e.g. "form-data", "attachment" or "inline".
+	Type string
+
+	// Name is the form field name of a form-data part.
+	Name string
+
+	// Filename is the name of the file without its directory, safe to be used as a file name, e.g.
+	// "report.pdf" for `filename="../../report.pdf"`. It is empty if the header has no file name or
+	// if the file name has no base name, e.g. "..".
+	Filename string
+
+	// Params are all parameters of the header with lower cased keys. RFC 5987 extended parameters,
+	// e.g. filename*, are decoded and stored without the asterisk.
+	Params map[string]string
+}
+
+// ParseContentDisposition parses the value of a Content-Disposition header. The RFC 5987 encoded
+// filename* parameter takes precedence over the filename parameter and its percent encoded UTF-8
+// value is decoded, e.g.:
+//
+//	filename*=UTF-8''%E2%82%AC%20rates.pdf
+//
+// The directory of the file name is removed, using both slashes and backslashes as separators, so
+// the file name can't refer to another directory. It returns [ErrInvalidDisposition] if the
+// value is malformed, or if the name or the file name is not valid UTF-8 or contains control
+// characters.
+func ParseContentDisposition(value string) (ContentDisposition, error) {
+	dispositionType, params, err := mime.ParseMediaType(value)
+	if err != nil {
+		return ContentDisposition{}, ErrInvalidDisposition
+	}
+
+	for _, key := range []string{"name", "filename"} {
+		if !isSafeDispositionParam(params[key]) {
+			return ContentDisposition{}, ErrInvalidDisposition
+		}
+	}
+
+	return ContentDisposition{
+		Type:     dispositionType,
+		Name:     params["name"],
+		Filename: baseFilename(params["filename"]),
+		Params:   params,
+	}, nil
+}
+
+// isSafeDispositionParam reports whether the parameter value is valid UTF-8 without control
+// characters.
+func isSafeDispositionParam(value string) bool {
+	return utf8.ValidString(value) && strings.IndexFunc(value, unicode.IsControl) < 0
+}
+
+// baseFilename returns the last element of the file name, e.g. "b.txt" for "a/b.txt" or
+// `C:\a\b.txt`. It returns an empty string if the last element is "." or "..".
+func baseFilename(filename string) string {
+	filename = filename[strings.LastIndexAny(filename, `/\`)+1:]
+	if filename == "." || filename == ".." {
+		return ""
+	}
+
+	return filename
+}
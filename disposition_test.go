@@ -0,0 +1,115 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContentDisposition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected reqparse.ContentDisposition
+		err      error
+	}{
+		{
+			name:  "form field",
+			value: `form-data; name="user_name"`,
+			expected: reqparse.ContentDisposition{
+				Type:   "form-data",
+				Name:   "user_name",
+				Params: map[string]string{"name": "user_name"},
+			},
+		},
+		{
+			name:  "form file",
+			value: `Form-Data; Name="avatar"; filename="me.png"`,
+			expected: reqparse.ContentDisposition{
+				Type:     "form-data",
+				Name:     "avatar",
+				Filename: "me.png",
+				Params:   map[string]string{"name": "avatar", "filename": "me.png"},
+			},
+		},
+		{
+			name:  "extended filename",
+			value: `attachment; filename="rates.pdf"; filename*=UTF-8''%E2%82%AC%20rates.pdf`,
+			expected: reqparse.ContentDisposition{
+				Type:     "attachment",
+				Filename: "€ rates.pdf",
+				Params:   map[string]string{"filename": "€ rates.pdf"},
+			},
+		},
+		{
+			name:  "directories removed",
+			value: `attachment; filename="../../etc/passwd"`,
+			expected: reqparse.ContentDisposition{
+				Type:     "attachment",
+				Filename: "passwd",
+				Params:   map[string]string{"filename": "../../etc/passwd"},
+			},
+		},
+		{
+			name:  "windows path",
+			value: `attachment; filename="C:\\Users\\me\\notes.txt"`,
+			expected: reqparse.ContentDisposition{
+				Type:     "attachment",
+				Filename: "notes.txt",
+				Params:   map[string]string{"filename": `C:\Users\me\notes.txt`},
+			},
+		},
+		{
+			name:  "no base name",
+			value: `attachment; filename=".."`,
+			expected: reqparse.ContentDisposition{
+				Type:   "attachment",
+				Params: map[string]string{"filename": ".."},
+			},
+		},
+		{
+			name:  "inline",
+			value: "inline",
+			expected: reqparse.ContentDisposition{
+				Type:   "inline",
+				Params: map[string]string{},
+			},
+		},
+		{name: "empty", value: "", err: reqparse.ErrInvalidDisposition},
+		{
+			name:  "unquoted space",
+			value: `attachment; filename=a b.txt`,
+			err:   reqparse.ErrInvalidDisposition,
+		},
+		{
+			name:  "invalid utf-8",
+			value: `attachment; filename*=UTF-8''%E2%82`,
+			err:   reqparse.ErrInvalidDisposition,
+		},
+		{
+			name:  "control character",
+			value: `attachment; filename*=UTF-8''a%0Ab.txt`,
+			err:   reqparse.ErrInvalidDisposition,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			disposition, err := reqparse.ParseContentDisposition(tc.value)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, disposition)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Package echobind provides an [Echo] binder that parses requests with reqparse.
+//
+// [Echo]: https://github.com/labstack/echo
+package echobind
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/labstack/echo/v4"
+)
+
+// Binder is an [echo.Binder] parsing the requests with [reqparse.ParseRequest]. Fields are bound
+// from the path parameters, query parameters, headers, cookies and JSON body of the request by
+// their `path`, `query`, `header`, `cookie` and `json` tags, with the defaults, required fields and
+// validation of reqparse. It can replace the default binder of an Echo instance:
+//
+//	e.Binder = echobind.NewBinder(nil)
+//
+// A Binder is safe for concurrent use.
+type Binder struct {
+	opts reqparse.ParseQueryOptions
+}
+
+var _ echo.Binder = (*Binder)(nil)
+
+// NewBinder creates a new [Binder] with the given options. If options are nil, default options are
+// used. The options are copied, so modifying them after calling NewBinder has no effect on the
+// returned binder. The PathParams option is ignored, path parameters are always obtained from the
+// Echo context.
+func NewBinder(opts *reqparse.ParseQueryOptions) *Binder {
+	b := &Binder{}
+	if opts != nil {
+		b.opts = *opts
+	}
+
+	return b
+}
+
+// Bind parses the request of the context into given struct. Validation errors are returned as an
+// [echo.HTTPError] with [http.StatusBadRequest] status, whose message and internal error is the
+// [reqparse.QueryValidationError]. Other errors, e.g. invalid struct tags, are returned as is.
+func (b *Binder) Bind(target any, c echo.Context) error {
+	opts := b.opts
+	opts.PathParams = func(*http.Request) map[string]string {
+		names, values := c.ParamNames(), c.ParamValues()
+
+		pathParams := make(map[string]string, len(names))
+		for i := 0; i < len(names) && i < len(values); i++ { //nolint:wsl
+			pathParams[names[i]] = values[i]
+		}
+
+		return pathParams
+	}
+
+	err := reqparse.ParseRequest(c.Request(), target, &opts)
+
+	var validationError *reqparse.QueryValidationError
+	if errors.As(err, &validationError) {
+		return echo.NewHTTPError(http.StatusBadRequest, validationError).SetInternal(err)
+	}
+
+	return err
+}
@@ -0,0 +1,111 @@
+package echobind_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/berk-karaal/reqparse/echobind"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type updateUserRequest struct {
+	UserID int    `path:"user_id"`
+	DryRun bool   `query:"dry_run" default:"false"`
+	Name   string `json:"name"     required:"true"`
+}
+
+// serve routes a request to the handler registered for the path of an Echo instance using the
+// binder.
+func serve(t *testing.T, binder echo.Binder, r *http.Request, handler echo.HandlerFunc) {
+	t.Helper()
+
+	e := echo.New()
+	e.Binder = binder
+	e.PUT("/users/:user_id", handler)
+
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestBinder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(
+			http.MethodPut, "/users/42?dry_run=true", strings.NewReader(`{"name":"berk"}`),
+		)
+		serve(t, echobind.NewBinder(nil), r, func(c echo.Context) error {
+			var req updateUserRequest
+			require.NoError(t, c.Bind(&req))
+			assert.Equal(t, updateUserRequest{UserID: 42, DryRun: true, Name: "berk"}, req)
+
+			return c.NoContent(http.StatusOK)
+		})
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodPut, "/users/abc", strings.NewReader(`{}`))
+		serve(t, echobind.NewBinder(nil), r, func(c echo.Context) error {
+			var req updateUserRequest
+			err := c.Bind(&req)
+
+			var httpError *echo.HTTPError
+			require.ErrorAs(t, err, &httpError)
+			assert.Equal(t, http.StatusBadRequest, httpError.Code)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"user_id": {"must be a valid integer"},
+				"name":    {"field is required"},
+			}, validationError.FieldErrors)
+			assert.Equal(t, validationError, httpError.Message)
+
+			return c.NoContent(http.StatusOK)
+		})
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		opts := &reqparse.ParseQueryOptions{Atomic: true}
+		r := httptest.NewRequest(http.MethodPut, "/users/42?dry_run=x", strings.NewReader(`{}`))
+		serve(t, echobind.NewBinder(opts), r, func(c echo.Context) error {
+			var req updateUserRequest
+			require.Error(t, c.Bind(&req))
+			assert.Equal(t, updateUserRequest{}, req)
+
+			return c.NoContent(http.StatusOK)
+		})
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		t.Parallel()
+
+		type invalidRequest struct {
+			UserID int
+		}
+
+		r := httptest.NewRequest(http.MethodPut, "/users/42", nil)
+		serve(t, echobind.NewBinder(nil), r, func(c echo.Context) error {
+			var req invalidRequest
+			err := c.Bind(&req)
+
+			require.ErrorIs(t, err, reqparse.ErrRequestTagNotFound)
+			assert.False(t, errors.As(err, new(*echo.HTTPError)))
+
+			return c.NoContent(http.StatusOK)
+		})
+	})
+}
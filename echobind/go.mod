@@ -0,0 +1,28 @@
+module github.com/berk-karaal/reqparse/echobind
+
+go 1.18
+
+require (
+	github.com/berk-karaal/reqparse v0.2.0
+	github.com/labstack/echo/v4 v4.12.0
+	github.com/stretchr/testify v1.10.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/labstack/gommon v0.4.2 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	github.com/valyala/fasttemplate v1.2.2 // indirect
+	golang.org/x/crypto v0.22.0 // indirect
+	golang.org/x/net v0.24.0 // indirect
+	golang.org/x/sys v0.19.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The reqparse module in the parent directory is used while developing in this repository. The
+// required version above is used by the dependents, so it must be a tagged release of reqparse.
+replace github.com/berk-karaal/reqparse => ../
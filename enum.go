@@ -0,0 +1,34 @@
+package reqparse
+
+import "reflect"
+
+// AllowedQueryValuer is implemented by the field types that declare their allowed values, e.g.
+// enum types. Fields of these types (and slices and pointers of them) are validated with
+// [OneOf] for the allowed values, so each struct using the type doesn't need a `validate` tag.
+// AllowedQueryValues is called once per field on the zero value of the type.
+type AllowedQueryValuer interface {
+	AllowedQueryValues() []string
+}
+
+//nolint:gochecknoglobals
+var allowedQueryValuerType = reflect.TypeOf((*AllowedQueryValuer)(nil)).Elem()
+
+// allowedValuesRule returns the rule validating the values of the field type against the allowed
+// values declared by its value type. ok is false if the value type doesn't implement
+// [AllowedQueryValuer] with a value or pointer receiver.
+func allowedValuesRule(fieldType reflect.Type, converters []Converter) (Rule, bool) {
+	valueType := fieldValueType(fieldType, converters)
+
+	var valuer AllowedQueryValuer
+
+	switch {
+	case valueType.Implements(allowedQueryValuerType):
+		valuer, _ = reflect.Zero(valueType).Interface().(AllowedQueryValuer)
+	case reflect.PointerTo(valueType).Implements(allowedQueryValuerType):
+		valuer, _ = reflect.New(valueType).Interface().(AllowedQueryValuer)
+	default:
+		return nil, false
+	}
+
+	return OneOf(valuer.AllowedQueryValues()...), true
+}
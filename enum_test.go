@@ -0,0 +1,82 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sortOrder string
+
+func (sortOrder) AllowedQueryValues() []string {
+	return []string{"asc", "desc"}
+}
+
+// String returns a display name, which is not used for validating the values.
+func (o sortOrder) String() string {
+	return "order " + string(o)
+}
+
+type priority int
+
+func (*priority) AllowedQueryValues() []string {
+	return []string{"1", "2", "3"}
+}
+
+func TestParseQueryAllowedValues(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Order      sortOrder   `query:"order"    default:"asc"`
+		Priorities []priority  `query:"priority"`
+		Then       *sortOrder  `query:"then"     validate:"oneof=desc"`
+		Orders     []sortOrder `query:"orders"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"priority": {"1", "3"},
+			"then":     {"desc"},
+			"orders":   {"desc", "asc"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		then := sortOrder("desc")
+		assert.Equal(t, MyStruct{
+			Order:      "asc",
+			Priorities: []priority{1, 3},
+			Then:       &then,
+			Orders:     []sortOrder{"desc", "asc"},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"order":    {"random"},
+			"priority": {"2", "4"},
+			"then":     {"asc"},
+			"orders":   {"up"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"order":    {"must be one of: asc, desc"},
+			"priority": {"(Index: 1) must be one of: 1, 2, 3"},
+			"then":     {"must be one of: desc"},
+			"orders":   {"(Index: 0) must be one of: asc, desc"},
+		}, validationErr.FieldErrors)
+	})
+}
@@ -0,0 +1,92 @@
+package reqparse
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// castError is the error of a value that can't be casted to the type of its field. Its message is
+// the message of the wrapped validation error; the value and the type are reported only if
+// [ParseQueryOptions.DetailedErrors] is set.
+type castError struct {
+	err        error
+	value      string
+	targetType reflect.Type
+}
+
+func (e *castError) Error() string {
+	return e.err.Error()
+}
+
+func (e *castError) Unwrap() error {
+	return e.err
+}
+
+// expected returns the description of the type the value is expected to be, e.g. "integer".
+func (e *castError) expected() string {
+	return expectedTypeName(e.targetType)
+}
+
+// detailedMessage appends the received value and the expected type to the message, e.g.
+// `must be a valid integer (got "abc", expected integer)`.
+func detailedMessage(message, value, expected string) string {
+	return message + " (got " + strconv.Quote(value) + ", expected " + expected + ")"
+}
+
+// detailParams returns a copy of the parameters of the casting error with the "value" and
+// "expected" parameters added.
+func detailParams(params map[string]any, value, expected string) map[string]any {
+	detailed := map[string]any{"value": value, "expected": expected}
+	for name, param := range params { //nolint:wsl
+		detailed[name] = param
+	}
+
+	return detailed
+}
+
+// expectedTypeName returns the description of the values of the type used in the detailed
+// errors, e.g. "integer" for the integer types or "IP address" for [netip.Addr]. Types without a
+// description, e.g. the types of the converters, are described by their Go names.
+func expectedTypeName(t reflect.Type) string {
+	switch t {
+	case timeType:
+		return "time"
+	case durationType:
+		return "duration"
+	case urlType:
+		return "URL"
+	case netipAddrType, netIPType:
+		return "IP address"
+	case netipPrefixType:
+		return "IP prefix"
+	case bigIntType:
+		return "integer"
+	case bigFloatType:
+		return "number"
+	case rangeHeaderType:
+		return "byte range"
+	case acceptLanguageType:
+		return "language list"
+	case rawMessageType:
+		return "JSON"
+	}
+
+	if isUUIDType(t) {
+		return "UUID"
+	}
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return "string"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "integer"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "unsigned integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Bool:
+		return "boolean"
+	default:
+		return t.String()
+	}
+}
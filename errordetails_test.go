@@ -0,0 +1,99 @@
+package reqparse_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryDetailedErrors(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Page    int           `query:"page"`
+		IDs     []uint        `query:"id"`
+		Price   *float64      `query:"price"`
+		Active  bool          `query:"active"`
+		Timeout time.Duration `query:"timeout"`
+		Addr    netip.Addr    `query:"addr"`
+		Limit   int           `query:"limit"   validate:"max=100"`
+	}
+
+	inputQueryParams := map[string][]string{
+		"page":    {"abc"},
+		"id":      {"1", "-2"},
+		"price":   {"cheap"},
+		"active":  {"maybe"},
+		"timeout": {"soon"},
+		"addr":    {"localhost"},
+		"limit":   {"500"},
+	}
+
+	t.Run("detailed errors", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			DetailedErrors: true,
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page": {`must be a valid integer (got "abc", expected integer)`},
+			"id": {
+				`(Index: 1) must be a valid unsigned integer (got "-2", expected unsigned integer)`,
+			},
+			"price":   {`must be a valid float (got "cheap", expected number)`},
+			"active":  {`must be a valid boolean (got "maybe", expected boolean)`},
+			"timeout": {`must be a valid duration (got "soon", expected duration)`},
+			"addr":    {`must be a valid IP address (got "localhost", expected IP address)`},
+			"limit":   {"must be less than or equal to 100"},
+		}, validationErr.FieldErrors)
+
+		fieldErrors := validationErr.FieldErrorList()
+		require.NotEmpty(t, fieldErrors)
+		assert.Equal(t, reqparse.FieldError{
+			Field:    "page",
+			Code:     reqparse.CodeInvalidInteger,
+			Message:  `must be a valid integer (got "abc", expected integer)`,
+			Value:    "abc",
+			Expected: "integer",
+			Source:   reqparse.SourceQuery,
+		}, fieldErrors[0])
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"must be a valid integer"}, validationErr.FieldErrors["page"])
+		assert.Empty(t, validationErr.FieldErrorList()[0].Value)
+	})
+
+	t.Run("message templates", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			DetailedErrors: true,
+			MessageCatalog: reqparse.MessageCatalog{
+				"tr": {reqparse.CodeInvalidInteger: "{expected} olmalıdır, {value} alındı"},
+			},
+			Language: "tr",
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"integer olmalıdır, abc alındı"},
+			validationErr.FieldErrors["page"])
+	})
+}
@@ -0,0 +1,68 @@
+// Package fiberbind parses the requests of [Fiber] and [fasthttp] with reqparse without converting
+// them to net/http requests.
+//
+// [Fiber]: https://github.com/gofiber/fiber
+// [fasthttp]: https://github.com/valyala/fasthttp
+package fiberbind
+
+import (
+	"strings"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// queryArgs is a [reqparse.QueryArgs] reading the query arguments of a fasthttp request.
+type queryArgs struct {
+	args *fasthttp.Args
+}
+
+// QueryArgs returns the arguments, e.g. ctx.QueryArgs() of a fasthttp request, as the query
+// parameters of [reqparse.ParseQueryArgs]. The values are copied, so the bound fields stay valid
+// after the request is handled.
+func QueryArgs(args *fasthttp.Args) reqparse.QueryArgs { //nolint:ireturn
+	return queryArgs{args: args}
+}
+
+// Values returns the values of the argument.
+func (a queryArgs) Values(key string) []string {
+	rawValues := a.args.PeekMulti(key)
+
+	values := make([]string, len(rawValues))
+	for i, value := range rawValues { //nolint:wsl
+		values[i] = string(value)
+	}
+
+	return values
+}
+
+// VisitAll calls f for each argument.
+func (a queryArgs) VisitAll(f func(key, value string)) {
+	for key, value := range a.args.All() {
+		f(string(key), string(value))
+	}
+}
+
+// ParseQuery parses the query parameters of the request into given struct with
+// [reqparse.ParseQueryArgs]. If options are nil, default options are used.
+func ParseQuery(c *fiber.Ctx, target any, opts *reqparse.ParseQueryOptions) error {
+	return reqparse.ParseQueryArgs(QueryArgs(c.Context().QueryArgs()), target, opts)
+}
+
+// PathParams returns the route parameters of the request. The values are copied, so the bound
+// fields stay valid after the request is handled.
+func PathParams(c *fiber.Ctx) map[string]string {
+	pathParams := c.AllParams()
+	for key, value := range pathParams { //nolint:wsl
+		pathParams[key] = strings.Clone(value)
+	}
+
+	return pathParams
+}
+
+// ParsePath parses the route parameters of the request into given struct with
+// [reqparse.ParsePath]. If options are nil, default options are used.
+func ParsePath(c *fiber.Ctx, target any, opts *reqparse.ParseQueryOptions) error {
+	return reqparse.ParsePath(PathParams(c), target, opts)
+}
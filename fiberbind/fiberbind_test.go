@@ -0,0 +1,139 @@
+package fiberbind_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/berk-karaal/reqparse/fiberbind"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+// serve routes a request to the handler registered for the path.
+func serve(t *testing.T, path string, target string, handler fiber.Handler) {
+	t.Helper()
+
+	app := fiber.New()
+	app.Get(path, handler)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, target, nil))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestQueryArgs(t *testing.T) {
+	t.Parallel()
+
+	type Query struct {
+		Page   int               `query:"page"   default:"1"`
+		Tags   []string          `query:"tag"`
+		Filter map[string]string `query:"filter"`
+	}
+
+	var args fasthttp.Args
+	args.Parse("tag=a&filter[color]=red&tag=b")
+
+	var q Query
+	require.NoError(t, reqparse.ParseQueryArgs(fiberbind.QueryArgs(&args), &q, nil))
+
+	args.Reset()
+	assert.Equal(t, Query{
+		Page:   1,
+		Tags:   []string{"a", "b"},
+		Filter: map[string]string{"color": "red"},
+	}, q)
+}
+
+func TestQueryArgsValues(t *testing.T) {
+	t.Parallel()
+
+	var args fasthttp.Args
+	args.Parse("tag=a&page=2&tag=b%20c")
+
+	queryArgs := fiberbind.QueryArgs(&args)
+	tags := queryArgs.Values("tag")
+
+	args.Reset()
+	assert.Equal(t, []string{"a", "b c"}, tags)
+	assert.Empty(t, queryArgs.Values("other"))
+}
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+
+	type Query struct {
+		Page int      `query:"page" default:"1"`
+		Tags []string `query:"tag"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/items", "/items?page=2&tag=a&tag=b", func(c *fiber.Ctx) error {
+			var q Query
+			require.NoError(t, fiberbind.ParseQuery(c, &q, nil))
+			assert.Equal(t, Query{Page: 2, Tags: []string{"a", "b"}}, q)
+
+			return nil
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/items", "/items?page=x", func(c *fiber.Ctx) error {
+			var q Query
+			err := fiberbind.ParseQuery(c, &q, nil)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"page": {"must be a valid integer"},
+			}, validationError.FieldErrors)
+
+			return nil
+		})
+	})
+}
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	type PathParams struct {
+		UserID int    `path:"user_id"`
+		Tab    string `path:"tab"     default:"profile"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/users/:user_id", "/users/42", func(c *fiber.Ctx) error {
+			var p PathParams
+			require.NoError(t, fiberbind.ParsePath(c, &p, nil))
+			assert.Equal(t, PathParams{UserID: 42, Tab: "profile"}, p)
+
+			return nil
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/users/:user_id", "/users/abc", func(c *fiber.Ctx) error {
+			var p PathParams
+			err := fiberbind.ParsePath(c, &p, nil)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"user_id": {"must be a valid integer"},
+			}, validationError.FieldErrors)
+
+			return nil
+		})
+	})
+}
@@ -0,0 +1,29 @@
+module github.com/berk-karaal/reqparse/fiberbind
+
+go 1.24.0
+
+require (
+	github.com/berk-karaal/reqparse v0.2.0
+	github.com/gofiber/fiber/v2 v2.52.11
+	github.com/stretchr/testify v1.10.0
+	github.com/valyala/fasthttp v1.69.0
+)
+
+require (
+	github.com/andybalholm/brotli v1.2.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/klauspost/compress v1.18.2 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/mattn/go-runewidth v0.0.16 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rivo/uniseg v0.2.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	golang.org/x/sys v0.39.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The reqparse module in the parent directory is used while developing in this repository. The
+// required version above is used by the dependents, so it must be a tagged release of reqparse.
+replace github.com/berk-karaal/reqparse => ../
@@ -0,0 +1,122 @@
+package reqparse
+
+import (
+	"reflect"
+	"sort"
+)
+
+// SortedFieldErrors returns the field errors with their codes grouped by field in
+// [QueryValidationError.FieldErrorKeys] order, which is the declaration order of the struct
+// fields for errors returned from the parsers. Errors of the same field are in the order they are
+// recorded. Unlike FieldErrors, the order doesn't depend on map iteration, so it can be used for
+// snapshot tests and stable API responses.
+func (e *QueryValidationError) SortedFieldErrors() []FieldError {
+	keyRanks := make(map[string]int, len(e.FieldErrors))
+	for rank, key := range e.FieldErrorKeys() { //nolint:wsl
+		keyRanks[key] = rank
+	}
+
+	sorted := e.FieldErrorList()
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keyRanks[sorted[i].Field] < keyRanks[sorted[j].Field]
+	})
+
+	return sorted
+}
+
+// sortFieldOrder orders the keys of the field errors by the declaration order of the fields of
+// the struct type, whose keys are returned by fieldKey. The errors of some fields, e.g. the errors
+// of the `required_if` tags and [ParseQueryOptions.Validator], are recorded after all fields are
+// bound, so the recorded order may differ from the declaration order. Keys that don't belong to a
+// struct field, e.g. the keys of the map entries, are placed after the keys of their fields, see
+// [ownerKeyRank].
+func (e *QueryValidationError) sortFieldOrder(structType reflect.Type, fieldKey fieldKeyFunc) {
+	state := e.mutableState()
+	if len(state.fieldOrder) <= 1 {
+		return
+	}
+
+	ranks := declaredKeyRanks(structType, fieldKey)
+
+	type rankedKey struct {
+		key  string
+		rank int
+	}
+
+	rankedKeys := make([]rankedKey, len(state.fieldOrder))
+	previousRank := -1
+
+	for i, key := range state.fieldOrder {
+		rank, ok := ranks[key]
+		if !ok {
+			rank, ok = ownerKeyRank(ranks, key)
+		}
+
+		if !ok {
+			rank = previousRank
+		}
+
+		rankedKeys[i] = rankedKey{key: key, rank: rank}
+		previousRank = rank
+	}
+
+	sort.SliceStable(rankedKeys, func(i, j int) bool {
+		return rankedKeys[i].rank < rankedKeys[j].rank
+	})
+
+	for i, rankedKey := range rankedKeys {
+		state.fieldOrder[i] = rankedKey.key
+	}
+}
+
+// declaredKeyRanks returns the positions of the keys of the fields of the struct type, including
+// the fields of the nested structs, in declaration order. Keys are returned by fieldKey.
+func declaredKeyRanks(structType reflect.Type, fieldKey fieldKeyFunc) map[string]int {
+	ranks := make(map[string]int)
+
+	var walk func(structType reflect.Type, path []string, parents []reflect.Type)
+	walk = func(structType reflect.Type, path []string, parents []reflect.Type) {
+		for i := 0; i < structType.NumField(); i++ {
+			structField := structType.Field(i)
+			fieldPath := append(path[:len(path):len(path)], structField.Name)
+
+			if key, ok := fieldKey(fieldPath); ok {
+				if _, seen := ranks[key]; !seen {
+					ranks[key] = len(ranks)
+				}
+			}
+
+			fieldType := structField.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() != reflect.Struct || containsType(parents, fieldType) {
+				continue
+			}
+
+			walk(fieldType, fieldPath, append(parents[:len(parents):len(parents)], fieldType))
+		}
+	}
+
+	walk(structType, nil, []reflect.Type{structType})
+
+	return ranks
+}
+
+// ownerKeyRank returns the rank of the longest key in ranks that the key starts with, followed by
+// "." or "[", e.g. the rank of "meta" for "meta[color]" or "items" for "items.0.name". It returns
+// false if there is no such key.
+func ownerKeyRank(ranks map[string]int, key string) (int, bool) {
+	for i := len(key) - 1; i > 0; i-- {
+		if key[i] != '.' && key[i] != '[' {
+			continue
+		}
+
+		if rank, ok := ranks[key[:i]]; ok {
+			return rank, true
+		}
+	}
+
+	return 0, false
+}
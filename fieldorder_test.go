@@ -0,0 +1,61 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryValidationErrorSortedFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	type Filter struct {
+		MinPrice int `query:"min_price"`
+		MaxPrice int `query:"max_price"`
+	}
+
+	type MyStruct struct {
+		SendAt *string        `query:"send_at" validate:"required_if=Type scheduled"`
+		Meta   map[string]int `query:"meta"`
+		Filter Filter         `query:"filter"`
+		Type   string         `query:"type"`
+		IDs    []int          `query:"id"`
+	}
+
+	inputQueryParams := map[string][]string{
+		"meta[b]":          {"x"},
+		"meta[a]":          {"y"},
+		"filter.max_price": {"z"},
+		"filter.min_price": {"1"},
+		"type":             {"scheduled"},
+		"id":               {"x", "y"},
+	}
+
+	for i := 0; i < 10; i++ {
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			MapKeyStyle: reqparse.MapKeyStyleBracket,
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			"send_at", "meta[a]", "meta[b]", "filter.max_price", "id",
+		}, validationErr.FieldErrorKeys())
+
+		fieldErrors := validationErr.SortedFieldErrors()
+		fields := make([]string, 0, len(fieldErrors))
+
+		for _, fieldErr := range fieldErrors {
+			fields = append(fields, fieldErr.Field)
+		}
+
+		assert.Equal(t, []string{
+			"send_at", "meta[a]", "meta[b]", "filter.max_price", "id", "id",
+		}, fields)
+		assert.Equal(t, reqparse.CodeRequired, fieldErrors[0].Code)
+		assert.Equal(t, newPointer(1), fieldErrors[len(fieldErrors)-1].Index)
+	}
+}
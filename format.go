@@ -0,0 +1,188 @@
+package reqparse
+
+import (
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+//nolint:gochecknoglobals
+var (
+	errInvalidEmail = newCodedError(
+		CodeInvalidFormat, "must be a valid email address", map[string]any{"format": "email"},
+	)
+	errInvalidIPv4 = newCodedError(
+		CodeInvalidFormat, "must be a valid IPv4 address", map[string]any{"format": "ipv4"},
+	)
+	errInvalidIPv6 = newCodedError(
+		CodeInvalidFormat, "must be a valid IPv6 address", map[string]any{"format": "ipv6"},
+	)
+	errInvalidHostname = newCodedError(
+		CodeInvalidFormat, "must be a valid hostname", map[string]any{"format": "hostname"},
+	)
+)
+
+const (
+	// maxHostnameLength is the maximum length of a hostname in characters.
+	maxHostnameLength = 253
+
+	// maxHostnameLabelLength is the maximum length of a label of a hostname in characters.
+	maxHostnameLabelLength = 63
+)
+
+// builtinFormats are the formats of the `format` tag available without registration, named like
+// the formats of JSON Schema.
+var builtinFormats = map[string]func(value string) error{ //nolint:gochecknoglobals
+	"email":    validateEmail,
+	"url":      validateURL,
+	"uuid":     validateUUID,
+	"ipv4":     validateIPv4,
+	"ipv6":     validateIPv6,
+	"hostname": validateHostname,
+}
+
+// registeredFormats holds the formats registered with [RegisterFormat]. Keys are the format names
+// and values are the validation functions.
+var registeredFormats sync.Map //nolint:gochecknoglobals
+
+// RegisterFormat registers a format of the `format` tag used by all parsers, e.g.
+// `format:"sku"`. String values of the fields with the tag are validated with validate, and the
+// messages of its errors are reported as the validation errors of the fields. Registering a
+// format with the name of a built-in or a previously registered format replaces it.
+//
+// RegisterFormat is meant to be called during program initialization, e.g. in an init function.
+// It is safe for concurrent use.
+func RegisterFormat(name string, validate func(value string) error) {
+	registeredFormats.Store(name, validate)
+
+	// Cached plans may have resolved the format before the registration.
+	structPlans.Range(func(key, _ any) bool {
+		structPlans.Delete(key)
+		return true
+	})
+}
+
+// lookupFormat returns the validation function of the format. The registered formats are searched
+// first, then the built-in formats.
+func lookupFormat(name string) (func(value string) error, bool) {
+	if validate, ok := registeredFormats.Load(name); ok {
+		return validate.(func(value string) error), true //nolint:forcetypeassert
+	}
+
+	validate, ok := builtinFormats[name]
+
+	return validate, ok
+}
+
+// formatRule returns the rule of the `format` tag of the field, e.g. `format:"email"`. ok is false
+// if there is no such tag. The tag is allowed only on string fields and slices and pointers of
+// them, and unknown formats cause [ErrInvalidTag] error.
+func formatRule(
+	structField reflect.StructField,
+	converters []Converter,
+) (rule Rule, ok bool, err error) {
+	name, ok := structField.Tag.Lookup("format")
+	if !ok {
+		return nil, false, nil
+	}
+
+	validate, ok := lookupFormat(name)
+	if !ok || fieldValueType(structField.Type, converters).Kind() != reflect.String {
+		return nil, false, fmt.Errorf("%w: format:%q (%s)", ErrInvalidTag, name, structField.Name)
+	}
+
+	return func(value any) error {
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.String {
+			return nil
+		}
+
+		err := validate(v.String())
+		if err == nil {
+			return nil
+		}
+
+		// Errors of the registered formats are reported with the code of the format errors.
+		if code, _ := errorCodeOf(err); code == CodeInvalid {
+			return newCodedError(CodeInvalidFormat, err.Error(), map[string]any{"format": name})
+		}
+
+		return err
+	}, true, nil
+}
+
+// validateEmail accepts a bare email address, e.g. "user@example.com". Addresses with a display
+// name, e.g. "User <user@example.com>", are invalid.
+func validateEmail(value string) error {
+	addr, err := mail.ParseAddress(value)
+	if err != nil || addr.Address != value {
+		return errInvalidEmail
+	}
+
+	return nil
+}
+
+// validateURL accepts an absolute URL with a host, e.g. "https://example.com/path".
+func validateURL(value string) error {
+	u, err := parseURL(value, urlKindAbsolute)
+	if err != nil || u.Host == "" {
+		return errInvalidURL
+	}
+
+	return nil
+}
+
+// validateUUID accepts a UUID in any of the forms accepted by [ParseUUID].
+func validateUUID(value string) error {
+	if _, err := ParseUUID(value); err != nil {
+		return errInvalidUUID
+	}
+
+	return nil
+}
+
+// validateIPv4 accepts an IPv4 address in dotted decimal notation, e.g. "192.0.2.1".
+func validateIPv4(value string) error {
+	addr, err := netip.ParseAddr(value)
+	if err != nil || !addr.Is4() {
+		return errInvalidIPv4
+	}
+
+	return nil
+}
+
+// validateIPv6 accepts an IPv6 address without a zone, e.g. "2001:db8::1".
+func validateIPv6(value string) error {
+	addr, err := netip.ParseAddr(value)
+	if err != nil || !addr.Is6() || addr.Zone() != "" {
+		return errInvalidIPv6
+	}
+
+	return nil
+}
+
+// validateHostname accepts a hostname as defined by RFC 1123, e.g. "api.example.com": dot
+// separated labels of letters, digits and hyphens, which don't start or end with a hyphen.
+func validateHostname(value string) error {
+	if value == "" || len(value) > maxHostnameLength {
+		return errInvalidHostname
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		if label == "" || len(label) > maxHostnameLabelLength ||
+			label[0] == '-' || label[len(label)-1] == '-' {
+			return errInvalidHostname
+		}
+
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return errInvalidHostname
+			}
+		}
+	}
+
+	return nil
+}
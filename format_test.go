@@ -0,0 +1,157 @@
+package reqparse_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryFormatTag(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Email    string   `format:"email"    query:"email"`
+		Callback *string  `format:"url"      query:"callback"`
+		ID       string   `format:"uuid"     query:"id"`
+		IPs      []string `format:"ipv4"     query:"ip"`
+		IPv6     string   `format:"ipv6"     query:"ipv6"`
+		Host     string   `format:"hostname" query:"host"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"email":    {"user@example.com"},
+			"callback": {"https://example.com/hook?x=1"},
+			"id":       {"f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+			"ip":       {"192.0.2.1", "10.0.0.1"},
+			"ipv6":     {"2001:db8::1"},
+			"host":     {"api.example-1.com"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		callback := "https://example.com/hook?x=1"
+		assert.Equal(t, MyStruct{
+			Email:    "user@example.com",
+			Callback: &callback,
+			ID:       "f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+			IPs:      []string{"192.0.2.1", "10.0.0.1"},
+			IPv6:     "2001:db8::1",
+			Host:     "api.example-1.com",
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"email":    {"User <user@example.com>"},
+			"callback": {"/hook"},
+			"id":       {"f81d4fae"},
+			"ip":       {"192.0.2.1", "2001:db8::1"},
+			"ipv6":     {"192.0.2.1"},
+			"host":     {"-api.example.com"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"email":    {"must be a valid email address"},
+			"callback": {"must be a valid URL"},
+			"id":       {"must be a valid UUID"},
+			"ip":       {"(Index: 1) must be a valid IPv4 address"},
+			"ipv6":     {"must be a valid IPv6 address"},
+			"host":     {"must be a valid hostname"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("hostnames", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := map[string]bool{
+			"localhost":                     true,
+			"EXAMPLE.com":                   true,
+			"1.example.com":                 true,
+			"example..com":                  false,
+			"example.com.":                  false,
+			"exa_mple.com":                  false,
+			"example-.com":                  false,
+			strings.Repeat("a", 64):         false,
+			strings.Repeat("a.", 127):       false,
+			strings.Repeat("a", 63):         true,
+			strings.Repeat("a.", 126) + "a": true,
+		}
+
+		for host, valid := range testCases {
+			var s struct {
+				Host string `format:"hostname" query:"host"`
+			}
+
+			err := reqparse.ParseQuery(map[string][]string{"host": {host}}, &s, nil)
+
+			if valid {
+				require.NoError(t, err, host)
+			} else {
+				require.Error(t, err, host)
+			}
+		}
+	})
+
+	t.Run("invalid format tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				ID int `format:"uuid" query:"id"`
+			}{},
+			&struct {
+				Value string `format:"unknown" query:"value"`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
+
+func TestRegisterFormat(t *testing.T) {
+	t.Parallel()
+
+	reqparse.RegisterFormat("test-sku", func(value string) error {
+		if !strings.HasPrefix(value, "SKU-") {
+			return errors.New("must be a valid SKU")
+		}
+
+		return nil
+	})
+
+	type MyStruct struct {
+		SKUs []string `format:"test-sku" query:"sku"`
+	}
+
+	var s MyStruct
+	err := reqparse.ParseQuery(map[string][]string{"sku": {"SKU-1"}}, &s, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"SKU-1"}, s.SKUs)
+
+	err = reqparse.ParseQuery(map[string][]string{"sku": {"SKU-1", "X-2"}}, &s, nil)
+
+	var validationErr *reqparse.QueryValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, map[string][]string{
+		"sku": {"(Index: 1) must be a valid SKU"},
+	}, validationErr.FieldErrors)
+}
@@ -0,0 +1,168 @@
+package reqparse
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ForwardedHop is an element of the forwarding chain of a request, added by a proxy for the
+// request it received.
+type ForwardedHop struct {
+	// For is the node of the client of the proxy, e.g. "192.0.2.60", "[2001:db8::1]:4711" or
+	// "unknown".
+	For string
+
+	// By is the node of the proxy interface that received the request. It is only available in the
+	// Forwarded header.
+	By string
+
+	// Proto and Host are the protocol and the Host header of the request received by the proxy.
+	Proto string
+	Host  string
+}
+
+// Forwarded is the forwarding information of a request.
+type Forwarded struct {
+	// ClientIP is the address of the client. It is the remote address of the request unless the
+	// remote address is a trusted proxy; then it is the rightmost address of the chain that is not
+	// a trusted proxy. It is the zero [netip.Addr] if that node is not an IP address, e.g.
+	// "unknown".
+	ClientIP netip.Addr
+
+	// Proto and Host are the protocol and the Host header of the request sent by the client. They
+	// are taken from the hop added by the trusted proxy closest to the client, or from the request
+	// itself if there is no such hop.
+	Proto string
+	Host  string
+
+	// Hops is the forwarding chain as listed in the headers, the hop closest to the client first.
+	// It includes the hops added by untrusted proxies, which may be forged by the client.
+	Hops []ForwardedHop
+}
+
+// ParseForwarded parses the forwarding information of the request from the RFC 7239 Forwarded
+// header, or from the X-Forwarded-For, X-Forwarded-Proto and X-Forwarded-Host headers if the
+// Forwarded header is missing. Only the hops added by [ParseQueryOptions.TrustedProxies] are used
+// for ClientIP, Proto and Host. If the Forwarded header is malformed, [ErrInvalidForwarded] is
+// returned. If options are nil, default options are used, which trust no proxies.
+func ParseForwarded(r *http.Request, opts *ParseQueryOptions) (Forwarded, error) {
+	return NewParser(opts).ParseForwarded(r)
+}
+
+// parseForwarded is the implementation of [Parser.ParseForwarded]. opts must be non-nil.
+func parseForwarded(r *http.Request, opts *ParseQueryOptions) (Forwarded, error) {
+	var (
+		hops []ForwardedHop
+		err  error
+	)
+
+	if values := r.Header.Values("Forwarded"); len(values) > 0 {
+		hops, err = parseForwardedHeader(values)
+		if err != nil {
+			return Forwarded{}, err
+		}
+	} else {
+		hops = parseXForwardedHeaders(r.Header)
+	}
+
+	forwarded := Forwarded{
+		ClientIP: parseNodeAddr(r.RemoteAddr),
+		Proto:    "http",
+		Host:     r.Host,
+		Hops:     hops,
+	}
+
+	if r.TLS != nil {
+		forwarded.Proto = "https"
+	}
+
+	// Walk the chain from the proxy closest to the server while the sender of the hop is trusted.
+	for i := len(hops) - 1; i >= 0; i-- {
+		if !forwarded.ClientIP.IsValid() || !isTrustedProxy(forwarded.ClientIP, opts) {
+			break
+		}
+
+		forwarded.ClientIP = parseNodeAddr(hops[i].For)
+
+		if hops[i].Proto != "" {
+			forwarded.Proto = hops[i].Proto
+		}
+
+		if hops[i].Host != "" {
+			forwarded.Host = hops[i].Host
+		}
+	}
+
+	return forwarded, nil
+}
+
+// parseForwardedHeader parses the values of the Forwarded header.
+func parseForwardedHeader(values []string) ([]ForwardedHop, error) {
+	hops := make([]ForwardedHop, 0)
+
+	for _, element := range splitHeaderList(strings.Join(values, ","), ',') {
+		params, ok := parseHeaderParams(splitHeaderList(element, ';'))
+		if !ok {
+			return nil, ErrInvalidForwarded
+		}
+
+		hops = append(hops, ForwardedHop{
+			For:   params["for"],
+			By:    params["by"],
+			Proto: strings.ToLower(params["proto"]),
+			Host:  params["host"],
+		})
+	}
+
+	return hops, nil
+}
+
+// parseXForwardedHeaders parses the legacy X-Forwarded-For, X-Forwarded-Proto and
+// X-Forwarded-Host headers. Protocols and hosts are assigned to the hops in order.
+func parseXForwardedHeaders(header http.Header) []ForwardedHop {
+	forValues := splitHeaderList(strings.Join(header.Values("X-Forwarded-For"), ","), ',')
+	protoValues := splitHeaderList(strings.Join(header.Values("X-Forwarded-Proto"), ","), ',')
+	hostValues := splitHeaderList(strings.Join(header.Values("X-Forwarded-Host"), ","), ',')
+
+	hops := make([]ForwardedHop, len(forValues))
+	for i, forValue := range forValues { //nolint:wsl
+		hops[i].For = forValue
+
+		if i < len(protoValues) {
+			hops[i].Proto = strings.ToLower(protoValues[i])
+		}
+
+		if i < len(hostValues) {
+			hops[i].Host = hostValues[i]
+		}
+	}
+
+	return hops
+}
+
+// parseNodeAddr parses the IP address of a node, e.g. "192.0.2.60", "192.0.2.60:4711" or
+// "[2001:db8::1]:4711". It returns the zero address if the node is not an IP address.
+func parseNodeAddr(node string) netip.Addr {
+	if addrPort, err := netip.ParseAddrPort(node); err == nil {
+		return addrPort.Addr().Unmap()
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(node, "["), "]"))
+	if err != nil {
+		return netip.Addr{}
+	}
+
+	return addr.Unmap()
+}
+
+// isTrustedProxy reports whether the address is in one of the trusted proxy prefixes.
+func isTrustedProxy(addr netip.Addr, opts *ParseQueryOptions) bool {
+	for _, prefix := range opts.TrustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
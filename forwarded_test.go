@@ -0,0 +1,153 @@
+package reqparse_test
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForwarded(t *testing.T) {
+	t.Parallel()
+
+	opts := &reqparse.ParseQueryOptions{
+		TrustedProxies: []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/8"),
+			netip.MustParsePrefix("2001:db8:cafe::/48"),
+		},
+	}
+
+	newRequest := func(remoteAddr string, header map[string]string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "http://internal.local/", nil)
+		r.RemoteAddr = remoteAddr
+		for name, value := range header { //nolint:wsl
+			r.Header.Set(name, value)
+		}
+
+		return r
+	}
+
+	t.Run("forwarded header", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRequest("10.0.0.2:5000", map[string]string{
+			"Forwarded": `for=198.51.100.7, for="[2001:db8:cafe::17]:4711";proto=HTTPS;` +
+				`host=example.com;by=10.0.0.1, for=10.0.0.1;host="internal.local"`,
+		})
+
+		forwarded, err := reqparse.ParseForwarded(r, opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, reqparse.Forwarded{
+			ClientIP: netip.MustParseAddr("198.51.100.7"),
+			Proto:    "https",
+			Host:     "example.com",
+			Hops: []reqparse.ForwardedHop{
+				{For: "198.51.100.7"},
+				{
+					For:   "[2001:db8:cafe::17]:4711",
+					By:    "10.0.0.1",
+					Proto: "https",
+					Host:  "example.com",
+				},
+				{For: "10.0.0.1", Host: "internal.local"},
+			},
+		}, forwarded)
+	})
+
+	t.Run("untrusted hops are not used", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRequest("10.0.0.2:5000", map[string]string{
+			"Forwarded": "for=192.0.2.1;host=forged.example, for=203.0.113.9;proto=https",
+		})
+
+		forwarded, err := reqparse.ParseForwarded(r, opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, netip.MustParseAddr("203.0.113.9"), forwarded.ClientIP)
+		assert.Equal(t, "https", forwarded.Proto)
+		assert.Equal(t, "internal.local", forwarded.Host)
+	})
+
+	t.Run("untrusted remote address", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRequest("203.0.113.9:5000", map[string]string{
+			"X-Forwarded-For":   "192.0.2.1",
+			"X-Forwarded-Proto": "https",
+		})
+		r.TLS = &tls.ConnectionState{}
+
+		forwarded, err := reqparse.ParseForwarded(r, opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, netip.MustParseAddr("203.0.113.9"), forwarded.ClientIP)
+		assert.Equal(t, "https", forwarded.Proto)
+		assert.Equal(t, "internal.local", forwarded.Host)
+		assert.Equal(t, []reqparse.ForwardedHop{{For: "192.0.2.1", Proto: "https"}}, forwarded.Hops)
+	})
+
+	t.Run("legacy headers", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRequest("10.0.0.2:5000", map[string]string{
+			"X-Forwarded-For":   "192.0.2.1, 10.0.0.1",
+			"X-Forwarded-Proto": "https",
+			"X-Forwarded-Host":  "example.com",
+		})
+
+		forwarded, err := reqparse.ParseForwarded(r, opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, reqparse.Forwarded{
+			ClientIP: netip.MustParseAddr("192.0.2.1"),
+			Proto:    "https",
+			Host:     "example.com",
+			Hops: []reqparse.ForwardedHop{
+				{For: "192.0.2.1", Proto: "https", Host: "example.com"},
+				{For: "10.0.0.1"},
+			},
+		}, forwarded)
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRequest("10.0.0.2:5000", map[string]string{"Forwarded": "for=unknown"})
+
+		forwarded, err := reqparse.ParseForwarded(r, opts)
+
+		require.NoError(t, err)
+		assert.False(t, forwarded.ClientIP.IsValid())
+	})
+
+	t.Run("no trusted proxies", func(t *testing.T) {
+		t.Parallel()
+
+		r := newRequest("10.0.0.2:5000", map[string]string{"Forwarded": "for=192.0.2.1"})
+
+		forwarded, err := reqparse.ParseForwarded(r, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, netip.MustParseAddr("10.0.0.2"), forwarded.ClientIP)
+		assert.Equal(t, "http", forwarded.Proto)
+	})
+
+	t.Run("invalid forwarded header", func(t *testing.T) {
+		t.Parallel()
+
+		for _, value := range []string{"for", `for="192.0.2.1`, "=192.0.2.1"} {
+			r := newRequest("10.0.0.2:5000", map[string]string{"Forwarded": value})
+
+			_, err := reqparse.ParseForwarded(r, opts)
+
+			require.ErrorIs(t, err, reqparse.ErrInvalidForwarded, value)
+		}
+	})
+}
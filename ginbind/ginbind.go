@@ -0,0 +1,125 @@
+// Package ginbind provides [Gin] bindings that parse requests with reqparse. Validation errors are
+// reported with [reqparse.QueryValidationError], whose field errors are keyed by the parameter
+// names.
+//
+// [Gin]: https://github.com/gin-gonic/gin
+package ginbind
+
+import (
+	"net/http"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+var (
+	// Query binds the query parameters with [reqparse.ParseQuery] using the default options, e.g.
+	// c.ShouldBindWith(&q, ginbind.Query).
+	Query = QueryBinding{} //nolint:gochecknoglobals
+
+	// Header binds the request headers with [reqparse.ParseHeader] using the default options.
+	Header = HeaderBinding{} //nolint:gochecknoglobals
+
+	// Uri binds the path parameters with [reqparse.ParsePath] using the default options, e.g.
+	// ginbind.Uri.BindUri(ginbind.Params(c), &p).
+	Uri = URIBinding{} //nolint:gochecknoglobals,revive,stylecheck
+)
+
+var (
+	_ binding.Binding    = QueryBinding{}
+	_ binding.Binding    = HeaderBinding{}
+	_ binding.BindingUri = URIBinding{}
+)
+
+// QueryBinding is a [binding.Binding] parsing the query parameters with [reqparse.ParseQuery].
+type QueryBinding struct {
+	// Options are the options of [reqparse.ParseQuery]. If nil, default options are used.
+	Options *reqparse.ParseQueryOptions
+}
+
+// Name returns the name of the binding.
+func (QueryBinding) Name() string {
+	return "reqparse-query"
+}
+
+// Bind parses the query parameters of the request into given struct.
+func (b QueryBinding) Bind(r *http.Request, target any) error {
+	return reqparse.ParseQuery(r.URL.Query(), target, b.Options)
+}
+
+// HeaderBinding is a [binding.Binding] parsing the request headers with [reqparse.ParseHeader].
+type HeaderBinding struct {
+	// Options are the options of [reqparse.ParseHeader]. If nil, default options are used.
+	Options *reqparse.ParseQueryOptions
+}
+
+// Name returns the name of the binding.
+func (HeaderBinding) Name() string {
+	return "reqparse-header"
+}
+
+// Bind parses the headers of the request into given struct.
+func (b HeaderBinding) Bind(r *http.Request, target any) error {
+	return reqparse.ParseHeader(r.Header, target, b.Options)
+}
+
+// URIBinding is a [binding.BindingUri] parsing the path parameters with [reqparse.ParsePath].
+type URIBinding struct {
+	// Options are the options of [reqparse.ParsePath]. If nil, default options are used.
+	Options *reqparse.ParseQueryOptions
+}
+
+// Name returns the name of the binding.
+func (URIBinding) Name() string {
+	return "reqparse-uri"
+}
+
+// BindUri parses the path parameters into given struct. If a parameter has multiple values, the
+// first one is used.
+func (b URIBinding) BindUri( //nolint:revive,stylecheck
+	params map[string][]string,
+	target any,
+) error {
+	pathParams := make(map[string]string, len(params))
+
+	for key, values := range params {
+		if len(values) > 0 {
+			pathParams[key] = values[0]
+		}
+	}
+
+	return reqparse.ParsePath(pathParams, target, b.Options)
+}
+
+// Params returns the path parameters of the context in the format of [binding.BindingUri].
+func Params(c *gin.Context) map[string][]string {
+	params := make(map[string][]string, len(c.Params))
+	for _, param := range c.Params { //nolint:wsl
+		params[param.Key] = append(params[param.Key], param.Value)
+	}
+
+	return params
+}
+
+// Bind parses the path parameters, query parameters, headers, cookies and JSON body of the request
+// into given struct with [reqparse.ParseRequest], using the path parameters of the context for the
+// fields with the `path` tag. If options are nil, default options are used; the PathParams option
+// is always replaced by the path parameters of the context.
+func Bind(c *gin.Context, target any, opts *reqparse.ParseQueryOptions) error {
+	bindOpts := reqparse.ParseQueryOptions{}
+	if opts != nil {
+		bindOpts = *opts
+	}
+
+	bindOpts.PathParams = func(*http.Request) map[string]string {
+		pathParams := make(map[string]string, len(c.Params))
+		for _, param := range c.Params { //nolint:wsl
+			pathParams[param.Key] = param.Value
+		}
+
+		return pathParams
+	}
+
+	return reqparse.ParseRequest(c.Request, target, &bindOpts)
+}
@@ -0,0 +1,160 @@
+package ginbind_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/berk-karaal/reqparse/ginbind"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() { //nolint:gochecknoinits
+	gin.SetMode(gin.TestMode)
+}
+
+// serve routes a request to the handler registered for the path.
+func serve(t *testing.T, path string, r *http.Request, handler gin.HandlerFunc) {
+	t.Helper()
+
+	router := gin.New()
+	router.Handle(r.Method, path, handler)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	type Query struct {
+		Page  int      `query:"page"  default:"1"`
+		Tags  []string `query:"tag"`
+		Limit *int     `query:"limit"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/items?page=2&tag=a&tag=b", nil)
+		serve(t, "/items", r, func(c *gin.Context) {
+			var q Query
+			require.NoError(t, c.ShouldBindWith(&q, ginbind.Query))
+			assert.Equal(t, Query{Page: 2, Tags: []string{"a", "b"}}, q)
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/items?page=x&limit=y", nil)
+		serve(t, "/items", r, func(c *gin.Context) {
+			var q Query
+			err := c.ShouldBindWith(&q, ginbind.Query)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"page":  {"must be a valid integer"},
+				"limit": {"must be a valid integer"},
+			}, validationError.FieldErrors)
+		})
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		b := ginbind.QueryBinding{Options: &reqparse.ParseQueryOptions{Atomic: true}}
+		assert.Equal(t, "reqparse-query", b.Name())
+
+		r := httptest.NewRequest(http.MethodGet, "/items?page=2&limit=y", nil)
+		serve(t, "/items", r, func(c *gin.Context) {
+			var q Query
+			require.Error(t, c.ShouldBindWith(&q, b))
+			assert.Equal(t, Query{}, q)
+		})
+	})
+}
+
+func TestHeader(t *testing.T) {
+	t.Parallel()
+
+	type Headers struct {
+		RequestID string `header:"X-Request-Id"`
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Request-Id", "abc")
+
+	serve(t, "/", r, func(c *gin.Context) {
+		var h Headers
+		require.NoError(t, c.ShouldBindWith(&h, ginbind.Header))
+		assert.Equal(t, Headers{RequestID: "abc"}, h)
+	})
+}
+
+func TestUri(t *testing.T) {
+	t.Parallel()
+
+	type PathParams struct {
+		UserID int `path:"user_id"`
+	}
+
+	serve(t, "/users/:user_id", httptest.NewRequest(http.MethodGet, "/users/abc", nil),
+		func(c *gin.Context) {
+			var p PathParams
+			err := ginbind.Uri.BindUri(ginbind.Params(c), &p)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"user_id": {"must be a valid integer"},
+			}, validationError.FieldErrors)
+		},
+	)
+}
+
+func TestBind(t *testing.T) {
+	t.Parallel()
+
+	type Request struct {
+		UserID int    `path:"user_id"`
+		DryRun bool   `query:"dry_run" default:"false"`
+		Name   string `json:"name"     required:"true"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(
+			http.MethodPut, "/users/42?dry_run=true", strings.NewReader(`{"name":"berk"}`),
+		)
+		serve(t, "/users/:user_id", r, func(c *gin.Context) {
+			var req Request
+			require.NoError(t, ginbind.Bind(c, &req, nil))
+			assert.Equal(t, Request{UserID: 42, DryRun: true, Name: "berk"}, req)
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodPut, "/users/abc", strings.NewReader(`{}`))
+		serve(t, "/users/:user_id", r, func(c *gin.Context) {
+			var req Request
+			err := ginbind.Bind(c, &req, nil)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"user_id": {"must be a valid integer"},
+				"name":    {"field is required"},
+			}, validationError.FieldErrors)
+		})
+	})
+}
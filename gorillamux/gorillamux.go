@@ -0,0 +1,44 @@
+// Package gorillamux binds the route variables of the requests routed by [gorilla/mux] with
+// reqparse.
+//
+// [gorilla/mux]: https://github.com/gorilla/mux
+package gorillamux
+
+import (
+	"net/http"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/gorilla/mux"
+)
+
+// PathParams returns the route variables of the request matched by gorilla/mux, i.e.
+// [mux.Vars]. It can be used as [reqparse.ParseQueryOptions.PathParams].
+func PathParams(r *http.Request) map[string]string {
+	vars := mux.Vars(r)
+	if vars == nil {
+		return map[string]string{}
+	}
+
+	return vars
+}
+
+// ParsePath parses the route variables of the request into given struct with
+// [reqparse.ParsePath]. If options are nil, default options are used.
+func ParsePath(r *http.Request, target any, opts *reqparse.ParseQueryOptions) error {
+	return reqparse.ParsePath(PathParams(r), target, opts)
+}
+
+// Bind parses the route variables and the query parameters of the request into given struct with
+// [reqparse.ParseRequest], using the route variables for the fields with the `path` tag. Fields
+// with the `header`, `cookie` and `json` tags are supported as well. If options are nil, default
+// options are used; the PathParams option is always replaced by [PathParams].
+func Bind(r *http.Request, target any, opts *reqparse.ParseQueryOptions) error {
+	bindOpts := reqparse.ParseQueryOptions{}
+	if opts != nil {
+		bindOpts = *opts
+	}
+
+	bindOpts.PathParams = PathParams
+
+	return reqparse.ParseRequest(r, target, &bindOpts)
+}
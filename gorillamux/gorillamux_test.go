@@ -0,0 +1,124 @@
+package gorillamux_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/berk-karaal/reqparse/gorillamux"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serve routes a request to the handler registered for the path template.
+func serve(t *testing.T, template string, target string, handler http.HandlerFunc) {
+	t.Helper()
+
+	router := mux.NewRouter()
+	router.HandleFunc(template, handler).Methods(http.MethodGet)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestPathParams(t *testing.T) {
+	t.Parallel()
+
+	t.Run("route variables", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/orgs/{org_id}/users/{user_id:[0-9]+}", "/orgs/7/users/42",
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, map[string]string{
+					"org_id":  "7",
+					"user_id": "42",
+				}, gorillamux.PathParams(r))
+			},
+		)
+	})
+
+	t.Run("not routed by mux", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		assert.Equal(t, map[string]string{}, gorillamux.PathParams(r))
+	})
+}
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	type PathParams struct {
+		UserID int    `path:"user_id"`
+		Tab    string `path:"tab"     default:"profile"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/users/{user_id}", "/users/42", func(w http.ResponseWriter, r *http.Request) {
+			var p PathParams
+			require.NoError(t, gorillamux.ParsePath(r, &p, nil))
+			assert.Equal(t, PathParams{UserID: 42, Tab: "profile"}, p)
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/users/{user_id}", "/users/abc", func(w http.ResponseWriter, r *http.Request) {
+			var p PathParams
+			err := gorillamux.ParsePath(r, &p, nil)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"user_id": {"must be a valid integer"},
+			}, validationError.FieldErrors)
+		})
+	})
+}
+
+func TestBind(t *testing.T) {
+	t.Parallel()
+
+	type Request struct {
+		UserID int      `path:"user_id"`
+		Page   int      `query:"page"    default:"1"`
+		Tags   []string `query:"tag"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/users/{user_id}", "/users/42?page=3&tag=a&tag=b",
+			func(w http.ResponseWriter, r *http.Request) {
+				var req Request
+				require.NoError(t, gorillamux.Bind(r, &req, nil))
+				assert.Equal(t, Request{UserID: 42, Page: 3, Tags: []string{"a", "b"}}, req)
+			},
+		)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		serve(t, "/users/{user_id}", "/users/abc?page=x",
+			func(w http.ResponseWriter, r *http.Request) {
+				var req Request
+				err := gorillamux.Bind(r, &req, &reqparse.ParseQueryOptions{Atomic: true})
+
+				var validationError *reqparse.QueryValidationError
+				require.ErrorAs(t, err, &validationError)
+				assert.Equal(t, map[string][]string{
+					"user_id": {"must be a valid integer"},
+					"page":    {"must be a valid integer"},
+				}, validationError.FieldErrors)
+				assert.Equal(t, Request{}, req)
+			},
+		)
+	})
+}
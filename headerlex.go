@@ -0,0 +1,91 @@
+package reqparse
+
+import "strings"
+
+// splitHeaderList splits the header value by the separator, ignoring the separators inside quoted
+// strings and angle brackets, e.g. `<https://example.com/?a=1,2>`. Elements are trimmed and empty
+// elements are skipped.
+func splitHeaderList(value string, sep byte) []string {
+	elements := make([]string, 0)
+
+	var (
+		start     int
+		inQuotes  bool
+		inBracket bool
+	)
+
+	for i := 0; i < len(value); i++ {
+		switch {
+		case inQuotes && value[i] == '\\':
+			i++
+		case !inBracket && value[i] == '"':
+			inQuotes = !inQuotes
+		case !inQuotes && (value[i] == '<' || value[i] == '>'):
+			inBracket = value[i] == '<'
+		case !inQuotes && !inBracket && value[i] == sep:
+			if element := strings.TrimSpace(value[start:i]); element != "" {
+				elements = append(elements, element)
+			}
+
+			start = i + 1
+		}
+	}
+
+	if element := strings.TrimSpace(value[start:]); element != "" {
+		elements = append(elements, element)
+	}
+
+	return elements
+}
+
+// unquoteHeaderValue returns the value of a token or quoted string, e.g. `"a \"b\""`. It returns
+// false if the value is an unterminated quoted string.
+func unquoteHeaderValue(value string) (string, bool) {
+	if !strings.HasPrefix(value, `"`) {
+		return value, true
+	}
+
+	if len(value) < 2 || !strings.HasSuffix(value, `"`) {
+		return "", false
+	}
+
+	var unquoted strings.Builder
+
+	for i := 1; i < len(value)-1; i++ {
+		if value[i] == '\\' {
+			i++
+			if i == len(value)-1 {
+				return "", false
+			}
+		}
+
+		unquoted.WriteByte(value[i])
+	}
+
+	return unquoted.String(), true
+}
+
+// parseHeaderParams parses the semicolon separated key=value parameters, e.g.
+// `for=192.0.2.60;proto=http`. Keys are lower cased. It returns false if a parameter is
+// malformed.
+func parseHeaderParams(params []string) (map[string]string, bool) {
+	parsed := make(map[string]string, len(params))
+
+	for _, param := range params {
+		key, value, ok := strings.Cut(param, "=")
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !ok || key == "" {
+			return nil, false
+		}
+
+		value, ok = unquoteHeaderValue(strings.TrimSpace(value))
+		if !ok {
+			return nil, false
+		}
+
+		parsed[key] = value
+	}
+
+	return parsed, true
+}
@@ -0,0 +1,43 @@
+package reqparse
+
+// ordered is the constraint of the types supporting the < <= >= > operators.
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// InRange reports whether v is in the closed interval [min, max].
+func InRange[T ordered](v, min, max T) bool { //nolint:predeclared
+	return v >= min && v <= max
+}
+
+// CastSlice casts each of the values with the given parse function.
+//
+// The returned slice has the same length as values. If a value can't be parsed, the zero value of
+// T is placed at its index. If all values are parsed successfully, the returned errors slice is
+// nil; otherwise it has the same length as values and errs[i] is the error returned for values[i]
+// (nil for the values that are parsed successfully).
+func CastSlice[T any](values []string, parse func(string) (T, error)) ([]T, []error) {
+	result := make([]T, len(values))
+
+	var errs []error
+
+	for i, value := range values {
+		v, err := parse(value)
+		if err != nil {
+			if errs == nil {
+				errs = make([]error, len(values))
+			}
+
+			errs[i] = err
+
+			continue
+		}
+
+		result[i] = v
+	}
+
+	return result, errs
+}
@@ -0,0 +1,83 @@
+package reqparse_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCastSlice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all values valid", func(t *testing.T) {
+		t.Parallel()
+
+		result, errs := reqparse.CastSlice([]string{"1", "2", "3"}, strconv.Atoi)
+
+		assert.Nil(t, errs)
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("some values invalid", func(t *testing.T) {
+		t.Parallel()
+
+		parseFloat := func(s string) (float64, error) {
+			return strconv.ParseFloat(s, 64)
+		}
+
+		result, errs := reqparse.CastSlice([]string{"1.5", "abc", "2", "x"}, parseFloat)
+
+		assert.Equal(t, []float64{1.5, 0, 2, 0}, result)
+		require.Len(t, errs, 4)
+		require.NoError(t, errs[0])
+		require.Error(t, errs[1])
+		require.NoError(t, errs[2])
+		require.Error(t, errs[3])
+	})
+
+	t.Run("parse function errors are returned as is", func(t *testing.T) {
+		t.Parallel()
+
+		errOdd := errors.New("odd")
+		_, errs := reqparse.CastSlice([]string{"a", "bb"}, func(s string) (string, error) {
+			if len(s)%2 == 1 {
+				return "", errOdd
+			}
+
+			return s, nil
+		})
+
+		assert.Equal(t, []error{errOdd, nil}, errs)
+	})
+
+	t.Run("empty values", func(t *testing.T) {
+		t.Parallel()
+
+		result, errs := reqparse.CastSlice([]string{}, strconv.ParseBool)
+
+		assert.Nil(t, errs)
+		assert.Equal(t, []bool{}, result)
+	})
+}
+
+func TestInRange(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, reqparse.InRange(5, 1, 10))
+	assert.True(t, reqparse.InRange(1, 1, 10))
+	assert.True(t, reqparse.InRange(10, 1, 10))
+	assert.False(t, reqparse.InRange(0, 1, 10))
+	assert.False(t, reqparse.InRange(11, 1, 10))
+
+	assert.True(t, reqparse.InRange(0.5, 0.0, 1.0))
+	assert.False(t, reqparse.InRange(1.01, 0.0, 1.0))
+
+	assert.True(t, reqparse.InRange("b", "a", "c"))
+	assert.False(t, reqparse.InRange("d", "a", "c"))
+
+	assert.False(t, reqparse.InRange(5, 10, 1))
+}
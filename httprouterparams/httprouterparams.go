@@ -0,0 +1,39 @@
+// Package httprouterparams binds the route parameters of the requests routed by [httprouter] with
+// reqparse.
+//
+// [httprouter]: https://github.com/julienschmidt/httprouter
+package httprouterparams
+
+import (
+	"net/http"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/julienschmidt/httprouter"
+)
+
+// PathParams converts the route parameters into the path parameters of [reqparse.ParsePath]. If a
+// parameter is repeated, the first value is used like [httprouter.Params.ByName].
+func PathParams(ps httprouter.Params) map[string]string {
+	pathParams := make(map[string]string, len(ps))
+
+	for _, p := range ps {
+		if _, ok := pathParams[p.Key]; !ok {
+			pathParams[p.Key] = p.Value
+		}
+	}
+
+	return pathParams
+}
+
+// RequestPathParams returns the route parameters stored in the request context, which are set by
+// httprouter for the handlers registered with [httprouter.Router.Handler] and
+// [httprouter.Router.HandlerFunc]. It can be used as [reqparse.ParseQueryOptions.PathParams].
+func RequestPathParams(r *http.Request) map[string]string {
+	return PathParams(httprouter.ParamsFromContext(r.Context()))
+}
+
+// ParsePath parses the route parameters into given struct with [reqparse.ParsePath]. If options
+// are nil, default options are used.
+func ParsePath(ps httprouter.Params, target any, opts *reqparse.ParseQueryOptions) error {
+	return reqparse.ParsePath(PathParams(ps), target, opts)
+}
@@ -0,0 +1,110 @@
+package httprouterparams_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/berk-karaal/reqparse/httprouterparams"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathParams(t *testing.T) {
+	t.Parallel()
+
+	ps := httprouter.Params{
+		{Key: "user_id", Value: "42"},
+		{Key: "filepath", Value: "/avatars/me.png"},
+		{Key: "user_id", Value: "7"},
+	}
+
+	assert.Equal(t, map[string]string{
+		"user_id":  "42",
+		"filepath": "/avatars/me.png",
+	}, httprouterparams.PathParams(ps))
+	assert.Equal(t, map[string]string{}, httprouterparams.PathParams(nil))
+}
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	type PathParams struct {
+		UserID int    `path:"user_id"`
+		Tab    string `path:"tab"     default:"profile"`
+	}
+
+	testCases := []struct {
+		name     string
+		target   string
+		expected PathParams
+		errors   map[string][]string
+	}{
+		{
+			name:     "valid",
+			target:   "/users/42",
+			expected: PathParams{UserID: 42, Tab: "profile"},
+		},
+		{
+			name:   "invalid",
+			target: "/users/abc",
+			errors: map[string][]string{"user_id": {"must be a valid integer"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := httprouter.New()
+			router.GET("/users/:user_id",
+				func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+					var p PathParams
+					err := httprouterparams.ParsePath(ps, &p, nil)
+
+					if tc.errors == nil {
+						require.NoError(t, err)
+						assert.Equal(t, tc.expected, p)
+
+						return
+					}
+
+					var validationError *reqparse.QueryValidationError
+					require.ErrorAs(t, err, &validationError)
+					assert.Equal(t, tc.errors, validationError.FieldErrors)
+				},
+			)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.target, nil))
+			require.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestRequestPathParams(t *testing.T) {
+	t.Parallel()
+
+	type Request struct {
+		UserID int `path:"user_id"`
+		Page   int `query:"page"    default:"1"`
+	}
+
+	opts := &reqparse.ParseQueryOptions{PathParams: httprouterparams.RequestPathParams}
+
+	router := httprouter.New()
+	router.HandlerFunc(http.MethodGet, "/users/:user_id",
+		func(w http.ResponseWriter, r *http.Request) {
+			var req Request
+			require.NoError(t, reqparse.ParseRequest(r, &req, opts))
+			assert.Equal(t, Request{UserID: 42, Page: 3}, req)
+		},
+	)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/42?page=3", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+}
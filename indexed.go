@@ -0,0 +1,96 @@
+package reqparse
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// errMissingValue is the validation error of the missing indexes of the indexed array keys.
+var errMissingValue = newCodedError( //nolint:gochecknoglobals
+	CodeMissingValue, "value is missing", nil,
+)
+
+// indexedValue is a value of an indexed array key, e.g. "items[2]".
+type indexedValue struct {
+	index int
+	value string
+}
+
+// indexedArrayValues returns the values of the indexed array keys of the field, e.g. "items[0]"
+// and "items[1]" for the field key "items", ordered by their indexes. Only the first value of
+// each key is used. missingIndexes are the first missing indexes of the gaps between the indexes,
+// e.g. [1] for "items[0]" and "items[2]"; values are nil if there are gaps.
+func indexedArrayValues(
+	sourceValues map[string][]string,
+	fieldKey string,
+) (values []string, missingIndexes []int) {
+	indexed := make([]indexedValue, 0)
+
+	for key, keyValues := range sourceValues {
+		if len(keyValues) == 0 {
+			continue
+		}
+
+		index, ok := arrayIndexFromKey(key, fieldKey)
+		if !ok {
+			continue
+		}
+
+		indexed = append(indexed, indexedValue{index: index, value: keyValues[0]})
+	}
+
+	if len(indexed) == 0 {
+		return nil, nil
+	}
+
+	sort.Slice(indexed, func(i, j int) bool { return indexed[i].index < indexed[j].index })
+
+	next := 0
+	for _, v := range indexed { //nolint:wsl
+		if v.index != next {
+			missingIndexes = append(missingIndexes, next)
+		}
+
+		next = v.index + 1
+	}
+
+	if missingIndexes != nil {
+		return nil, missingIndexes
+	}
+
+	values = make([]string, len(indexed))
+	for i, v := range indexed { //nolint:wsl
+		values[i] = v.value
+	}
+
+	return values, nil
+}
+
+// arrayIndexFromKey extracts the array index from the key, e.g. 2 from "items[2]" for the field key
+// "items". It returns false if the key is not an indexed array key of the field. Indexes with
+// leading zeros, e.g. "items[01]", are not valid.
+func arrayIndexFromKey(key, fieldKey string) (int, bool) {
+	if len(key) < len(fieldKey)+3 || !strings.HasPrefix(key, fieldKey) ||
+		key[len(fieldKey)] != '[' || key[len(key)-1] != ']' {
+		return 0, false
+	}
+
+	digits := key[len(fieldKey)+1 : len(key)-1]
+	if len(digits) > 1 && digits[0] == '0' {
+		return 0, false
+	}
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+
+	index, err := strconv.Atoi(digits)
+	if err != nil {
+		return 0, false
+	}
+
+	return index, true
+}
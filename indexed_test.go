@@ -0,0 +1,75 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryIndexedArrays(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Items  []string            `query:"items"`
+		IDs    []int               `query:"ids"`
+		Tags   *[]string           `query:"tags"`
+		Sizes  []int               `query:"sizes" default:"1"`
+		Others map[string][]string `query:"*"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"items[1]":  {"b"},
+			"items[0]":  {"a", "ignored"},
+			"items[2]":  {"c"},
+			"ids":       {"7"},
+			"ids[0]":    {"8"},
+			"tags[0]":   {"x"},
+			"items[01]": {"leading zero"},
+			"items[-1]": {"negative"},
+			"items[]":   {"empty"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"a", "b", "c"}, s.Items)
+		assert.Equal(t, []int{7}, s.IDs)
+		assert.Equal(t, &[]string{"x"}, s.Tags)
+		assert.Equal(t, []int{1}, s.Sizes)
+		assert.Equal(t, map[string][]string{
+			"items[01]": {"leading zero"},
+			"items[-1]": {"negative"},
+			"items[]":   {"empty"},
+		}, s.Others)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"items[0]":          {"a"},
+			"items[3]":          {"d"},
+			"items[5]":          {"f"},
+			"ids[0]":            {"1"},
+			"ids[1]":            {"x"},
+			"sizes[1000000000]": {"1"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"items": {"(Index: 1) value is missing", "(Index: 4) value is missing"},
+			"ids":   {"(Index: 1) must be a valid integer"},
+			"sizes": {"(Index: 0) value is missing"},
+		}, validationErr.FieldErrors)
+	})
+}
@@ -0,0 +1,119 @@
+package reqparse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// baseFromPrefix is the integer base of the `base:"0"` tag, which selects the base of each value
+// by its prefix like Go integer literals: "0b" for base 2, "0o" or "0" for base 8 and "0x" for
+// base 16. Base 0 isn't stored as is since zero castOptions parse the integers in base 10.
+const baseFromPrefix = -1
+
+// Bases accepted by [strconv.ParseInt] besides 0, and the default base.
+const (
+	minIntBase  = 2
+	maxIntBase  = 36
+	decimalBase = 10
+)
+
+// intBaseFromTag returns the base in the `base` tag of the field, which selects the base of the
+// integer values, e.g. `base:"16"` for `?id=ff`. It is zero if there is no such tag, i.e. the
+// values are parsed in base 10, and [baseFromPrefix] for `base:"0"`. The tag is allowed only on
+// integer fields and slices, pointers and maps of them.
+func intBaseFromTag(structField reflect.StructField, converters []Converter) (int, error) {
+	tag, ok := structField.Tag.Lookup("base")
+	if !ok {
+		return 0, nil
+	}
+
+	base, err := strconv.Atoi(tag)
+	if err != nil || (base != 0 && (base < minIntBase || base > maxIntBase)) ||
+		!isIntegerField(structField.Type, converters) {
+		return 0, fmt.Errorf("%w: base:%q (%s)", ErrInvalidTag, tag, structField.Name)
+	}
+
+	if base == 0 {
+		return baseFromPrefix, nil
+	}
+
+	return base, nil
+}
+
+// isIntegerField reports whether the field is an integer field or a slice, pointer or map of
+// integer elements. Durations and types with a converter or implementing
+// [encoding.TextUnmarshaler] are not integer fields even if their kinds are integer kinds.
+func isIntegerField(fieldType reflect.Type, converters []Converter) bool {
+	if bindingKind(fieldType, converters) == reflect.Map {
+		fieldType = fieldType.Elem()
+	}
+
+	fieldType = fieldValueType(fieldType, converters)
+
+	if _, ok := lookupConverter(fieldType, converters); ok {
+		return false
+	}
+
+	if fieldType == durationType || isTextUnmarshalerType(fieldType) {
+		return false
+	}
+
+	switch fieldType.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseIntBase returns the base passed to [strconv.ParseInt] and [strconv.ParseUint].
+func (o castOptions) parseIntBase() int {
+	switch o.intBase {
+	case 0:
+		return decimalBase
+	case baseFromPrefix:
+		return 0
+	default:
+		return o.intBase
+	}
+}
+
+// integerError returns the validation error for the error returned by [strconv.ParseInt] or
+// [strconv.ParseUint] for an integer type with the given bit size. Values out of the range of the
+// type are reported with the range instead of being truncated. Invalid values of the fields with a
+// `base` tag other than 10 and 0 are reported with the base.
+func (o castOptions) integerError(err error, bitSize int, unsigned bool) error {
+	if !errors.Is(err, strconv.ErrRange) {
+		invalidErr := errInvalidInteger
+		if unsigned {
+			invalidErr = errInvalidUnsigned
+		}
+
+		if o.intBase > 0 && o.intBase != decimalBase {
+			return fmt.Errorf("%w in base %d", invalidErr, o.intBase)
+		}
+
+		return invalidErr
+	}
+
+	if unsigned {
+		maxValue := ^uint64(0) >> (64 - bitSize)
+
+		return newCodedError(
+			CodeOutOfRange,
+			fmt.Sprintf("must be between 0 and %d", maxValue),
+			map[string]any{"min": 0, "max": maxValue},
+		)
+	}
+
+	minValue := int64(-1) << (bitSize - 1)
+
+	return newCodedError(
+		CodeOutOfRange,
+		fmt.Sprintf("must be between %d and %d", minValue, ^minValue),
+		map[string]any{"min": minValue, "max": ^minValue},
+	)
+}
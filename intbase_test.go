@@ -0,0 +1,110 @@
+package reqparse_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryIntegerBase(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Color  uint32         `query:"color"  base:"16"`
+		Mode   *int           `query:"mode"   base:"8"`
+		Flags  []uint8        `query:"flag"   base:"2"`
+		Any    []int64        `query:"any"    base:"0"`
+		Limits map[string]int `query:"limits" base:"16"`
+		Parent sql.NullInt64  `query:"parent" base:"36" default:"z"`
+		Page   int            `query:"page"   base:"10"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"color":         {"FF8800"},
+			"mode":          {"755"},
+			"flag":          {"101", "11111111"},
+			"any":           {"0x1F", "0o17", "017", "0b11", "-42", "1_000"},
+			"limits[users]": {"a"},
+			"page":          {"2"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		mode := 0o755
+		assert.Equal(t, MyStruct{
+			Color:  0xFF8800,
+			Mode:   &mode,
+			Flags:  []uint8{5, 255},
+			Any:    []int64{31, 15, 15, 3, -42, 1000},
+			Limits: map[string]int{"users": 10},
+			Parent: sql.NullInt64{Int64: 35, Valid: true},
+			Page:   2,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"color":  {"0xFF"},
+			"mode":   {"8"},
+			"flag":   {"2", "100000000"},
+			"any":    {"0x", "ff"},
+			"parent": {"-"},
+			"page":   {"x"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"color": {"must be a valid unsigned integer in base 16"},
+			"mode":  {"must be a valid integer in base 8"},
+			"flag": {
+				"(Index: 0) must be a valid unsigned integer in base 2",
+				"(Index: 1) must be between 0 and 255",
+			},
+			"any": {
+				"(Index: 0) must be a valid integer",
+				"(Index: 1) must be a valid integer",
+			},
+			"parent": {"must be a valid integer in base 36"},
+			"page":   {"must be a valid integer"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid base tag", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidBase struct {
+			ID int `query:"id" base:"1"`
+		}
+
+		err := reqparse.ParseQuery(map[string][]string{}, &InvalidBase{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type NotNumber struct {
+			ID int `query:"id" base:"hex"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &NotNumber{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type NotInteger struct {
+			Price float64 `query:"price" base:"16"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &NotInteger{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
@@ -0,0 +1,300 @@
+package reqparse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// jsonSourceDescription is the description of JSON bodies used in the validation error messages.
+const jsonSourceDescription = "JSON body"
+
+// Errors of the body values (e.g. JSON or YAML) that can't be decoded into the field types, see
+// [typeError].
+//
+//nolint:gochecknoglobals
+var (
+	errInvalidString = newCodedError(CodeInvalidString, "must be a valid string", nil)
+	errInvalidArray  = newCodedError(CodeInvalidArray, "must be a valid array", nil)
+	errInvalidObject = newCodedError(CodeInvalidObject, "must be a valid object", nil)
+)
+
+// ParseJSON decodes the JSON object read from r into given struct. Object keys are matched with the
+// top-level fields by the name in the `json` tag, or the field name if the tag has no name, and
+// case-insensitively if there is no exact match. Fields tagged with `json:"-"` and unexported
+// fields are ignored. Unlike [encoding/json], embedded structs are not flattened: they are bound
+// from the key of their type name like the other fields. The options of the `json` tag, e.g.
+// ",string", are not supported for the top-level fields; they apply to the fields of the nested
+// structs, which are decoded with [encoding/json].
+//
+// Each field is decoded separately, so all invalid fields are reported in the FieldErrors of the
+// returned [QueryValidationError] instead of only the first one. Keys of the field errors are the
+// JSON names of the fields; errors of nested values are reported with dotted keys, e.g.
+// "address.zip". Fields of the types supported by [ParseQuery] work like query parameters: the
+// `default`, `required`, `validate` and `preset` tags and [ParseQueryOptions.FieldValidators] are
+// applied to them, so an absent or null non-pointer field without a default value is reported as
+// required. Absent pointer, slice and nullable fields are left untouched. Fields of the other
+// types, e.g. maps, are optional unless they have `required:"true"` tag.
+//
+// If the body is not a valid JSON object, a [QueryValidationError] with a struct error is returned.
+// Errors reading from r are returned as is. If options are nil, default options are used.
+func ParseJSON(r io.Reader, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseJSON(r, target)
+}
+
+// parseJSON is the implementation of [Parser.ParseJSON]. opts must be non-nil.
+func parseJSON(r io.Reader, target any, opts *ParseQueryOptions) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryTarget
+	}
+
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	validationErrors := newQueryValidationError(SourceBody, jsonSourceDescription, opts)
+
+	objectFields, structError := decodeJSONObject(body)
+	if structError != "" {
+		validationErrors.addStructError(structError)
+		return validationErrors.err()
+	}
+
+	structElem := v.Elem()
+	if opts.Atomic {
+		structElem = reflect.New(structElem.Type()).Elem()
+	}
+
+	bindable := false
+	boundFieldIndexes := make([]int, 0, structElem.NumField())
+
+	for i := 0; i < structElem.NumField(); i++ {
+		fieldv := structElem.Field(i)
+		structField := structElem.Type().Field(i)
+
+		fieldKey := jsonFieldKey(structField)
+		if !structField.IsExported() || fieldKey == "-" {
+			continue
+		}
+
+		bindable = true
+
+		// Absent fields are left untouched, so they are not copied into the target either.
+		ok, err := bindJSONField(
+			fieldv, structField, fieldKey, objectFields, opts, validationErrors,
+		)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			boundFieldIndexes = append(boundFieldIndexes, i)
+		}
+	}
+
+	return completeBinding(
+		target, structElem, bindable, boundFieldIndexes, validationErrors, opts,
+		taggedFieldKey(structElem.Type(), boundJSONFieldKey),
+	)
+}
+
+// decodeJSONObject decodes the body into the raw values of the object keys. If the body is not a
+// valid JSON object, the struct error message describing the problem is returned.
+func decodeJSONObject(body []byte) (map[string]json.RawMessage, string) {
+	var objectFields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &objectFields); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, "request body must be a JSON object"
+		}
+
+		return nil, "request body must be valid JSON"
+	}
+
+	if objectFields == nil {
+		// The body is null.
+		objectFields = map[string]json.RawMessage{}
+	}
+
+	return objectFields, ""
+}
+
+// bindJSONField decodes the value of the object key into the struct field. It returns false if
+// the object has no such key. Fields with the types supported by [ParseQuery] work the same way as
+// query parameters: absent and null values are handled with the `default` and `required` tags, and
+// the decoded values are checked with the rules. Fields of the other types are optional unless
+// they have `required:"true"` tag.
+func bindJSONField(
+	fieldv reflect.Value,
+	structField reflect.StructField,
+	fieldKey string,
+	objectFields map[string]json.RawMessage,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) (bool, error) {
+	raw, ok := lookupJSONField(objectFields, fieldKey)
+	isNull := ok && bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+
+	if fieldv.Kind() == reflect.Map ||
+		!isFieldTypeAllowedForQueryParsing(fieldv.Type(), opts.Converters) {
+		validationErrors.markPresence(fieldKey, ok && !isNull)
+
+		switch {
+		case (!ok || isNull) && structField.Tag.Get("required") == "true":
+			validationErrors.addFieldErr(fieldKey, noIndex, errRequired)
+		case !ok:
+			// Absent optional fields are left untouched.
+		default:
+			decodeJSONField(fieldv, fieldKey, raw, validationErrors)
+		}
+
+		return ok, nil
+	}
+
+	plan, err := newFieldPlan(structField, fieldKey, opts)
+	if err != nil {
+		return false, err
+	}
+
+	if !ok || isNull {
+		if !ok && plan.isOptional() {
+			// Absent optional fields are left untouched.
+			return false, nil
+		}
+
+		// Default values and required fields are handled the same way as absent query parameters.
+		return ok, plan.populate(fieldv, map[string][]string{}, opts, validationErrors)
+	}
+
+	validationErrors.markPresence(fieldKey, true)
+
+	if !decodeJSONField(fieldv, fieldKey, raw, validationErrors) {
+		return true, nil
+	}
+
+	rules, err := plan.resolveRules(opts)
+	if err != nil {
+		return false, err
+	}
+
+	plan.validateValue(fieldv, rules, validationErrors)
+
+	return true, nil
+}
+
+// decodeJSONField decodes the raw value into the field. It returns false and adds a validation
+// error if the value can't be decoded into the field type.
+func decodeJSONField(
+	fieldv reflect.Value,
+	fieldKey string,
+	raw json.RawMessage,
+	validationErrors *QueryValidationError,
+) bool {
+	if err := json.Unmarshal(raw, fieldv.Addr().Interface()); err != nil {
+		errorKey, fieldErr := jsonFieldError(fieldKey, fieldv.Type(), err)
+		validationErrors.addFieldErr(errorKey, noIndex, fieldErr)
+
+		return false
+	}
+
+	return true
+}
+
+// jsonFieldKey returns the JSON object key of the struct field the same way as [encoding/json].
+func jsonFieldKey(structField reflect.StructField) string {
+	tag := structField.Tag.Get("json")
+	if tag == "-" {
+		return tag
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return structField.Name
+	}
+
+	return name
+}
+
+// boundJSONFieldKey returns the JSON object key of the struct field, and false if the field isn't
+// bound from the JSON object.
+func boundJSONFieldKey(structField reflect.StructField) (string, bool) {
+	fieldKey := jsonFieldKey(structField)
+
+	return fieldKey, structField.IsExported() && fieldKey != "-"
+}
+
+// lookupJSONField returns the raw value of the object key. Like [encoding/json], an exact match is
+// preferred over a case-insensitive one. If more than one key matches case-insensitively, the
+// first one in byte order is used, so the result doesn't depend on the map iteration order.
+func lookupJSONField(
+	objectFields map[string]json.RawMessage,
+	fieldKey string,
+) (json.RawMessage, bool) {
+	if raw, ok := objectFields[fieldKey]; ok {
+		return raw, true
+	}
+
+	matchedKey, matched := "", false
+
+	for key := range objectFields {
+		if strings.EqualFold(key, fieldKey) && (!matched || key < matchedKey) {
+			matchedKey, matched = key, true
+		}
+	}
+
+	return objectFields[matchedKey], matched
+}
+
+// jsonFieldError returns the key and the validation error for the decoding error of the field.
+// Errors of nested values are keyed by their dotted paths.
+func jsonFieldError(fieldKey string, fieldType reflect.Type, err error) (string, error) {
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		return fieldKey, typeError(fieldType)
+	}
+
+	if typeErr.Field != "" {
+		fieldKey += "." + typeErr.Field
+	}
+
+	return fieldKey, typeError(typeErr.Type)
+}
+
+// typeError returns the validation error for a body value (e.g. JSON or YAML) that can't be
+// decoded into the given type.
+func typeError(targetType reflect.Type) error {
+	for targetType.Kind() == reflect.Pointer {
+		targetType = targetType.Elem()
+	}
+
+	if targetType == timeType {
+		return errInvalidDate
+	}
+
+	if err := textTypeError(targetType); err != nil {
+		return err
+	}
+
+	switch targetType.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return errInvalidInteger
+	case reflect.Float32, reflect.Float64:
+		return errInvalidFloat
+	case reflect.Bool:
+		return errInvalidBoolean
+	case reflect.String:
+		return errInvalidString
+	case reflect.Slice, reflect.Array:
+		return errInvalidArray
+	case reflect.Map, reflect.Struct:
+		return errInvalidObject
+	default:
+		return errInvalidValue
+	}
+}
@@ -0,0 +1,295 @@
+package reqparse_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestParseJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		type Address struct {
+			City string `json:"city"`
+			Zip  int    `json:"zip"`
+		}
+
+		type MyStruct struct {
+			Name      string    `json:"name"                required:"true"`
+			Age       int       `json:"age,omitempty"`
+			Tags      []string  `json:"tags"`
+			Address   *Address  `json:"address"`
+			CreatedAt time.Time `json:"created_at"`
+			Nickname  string
+			Ignored   string  `json:"-"`
+			Untouched *string `json:"untouched"`
+		}
+
+		body := `{
+			"name": "Berk",
+			"age": 25,
+			"tags": ["a", "b"],
+			"address": {"city": "Izmir", "zip": 35000},
+			"created_at": "2024-01-02T03:04:05Z",
+			"nickname": "bk",
+			"-": "value",
+			"unknown": true
+		}`
+
+		untouched := "initial"
+		s := MyStruct{Untouched: &untouched}
+		err := reqparse.ParseJSON(strings.NewReader(body), &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Name:      "Berk",
+			Age:       25,
+			Tags:      []string{"a", "b"},
+			Address:   &Address{City: "Izmir", Zip: 35000},
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Nickname:  "bk",
+			Untouched: &untouched,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		type Address struct {
+			Zip int `json:"zip"`
+		}
+
+		type MyStruct struct {
+			Name      string    `json:"name"       required:"true"`
+			Email     *string   `json:"email"      required:"true"`
+			Age       int       `json:"age"`
+			Price     float64   `json:"price"`
+			Active    bool      `json:"active"`
+			Title     string    `json:"title"`
+			Tags      []int     `json:"tags"`
+			Address   Address   `json:"address"`
+			CreatedAt time.Time `json:"created_at"`
+			Valid     string    `json:"valid"`
+		}
+
+		body := `{
+			"email": null,
+			"age": "25",
+			"price": true,
+			"active": "yes",
+			"title": 5,
+			"tags": "1,2",
+			"address": {"zip": "35000"},
+			"created_at": "yesterday",
+			"valid": "ok"
+		}`
+
+		var s MyStruct
+		err := reqparse.ParseJSON(strings.NewReader(body), &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name":        {"field is required"},
+			"email":       {"field is required"},
+			"age":         {"must be a valid integer"},
+			"price":       {"must be a valid float"},
+			"active":      {"must be a valid boolean"},
+			"title":       {"must be a valid string"},
+			"tags":        {"must be a valid array"},
+			"address.zip": {"must be a valid integer"},
+			"created_at":  {"must be a valid date"},
+		}, validationError.FieldErrors)
+		assert.Empty(t, validationError.StructErrors)
+		assert.Equal(t, []string{
+			"name", "email", "age", "price", "active", "title", "tags", "address.zip", "created_at",
+		}, validationError.FieldErrorKeys())
+		assert.Contains(t, validationError.Error(), "Parsing JSON body failed.")
+	})
+
+	t.Run("defaults and rules", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name     string   `json:"name"      validate:"min=3"`
+			Page     int      `json:"page"      default:"1"`
+			PageSize int      `json:"page_size" preset:"page_size"     default:"20"`
+			Tags     []string `json:"tags"      validate:"maxitems=2"`
+			Age      int      `json:"age"`
+			Nickname *string  `json:"nickname"  validate:"min=2"`
+		}
+
+		opts := &reqparse.ParseQueryOptions{
+			Presets:         map[string][]reqparse.Rule{"page_size": {reqparse.Max(100)}},
+			FieldValidators: map[string][]reqparse.Rule{"tags": {reqparse.MaxLength(3)}},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseJSON(strings.NewReader(`{"name": "Berk", "age": 25}`), &s, opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: "Berk", Page: 1, PageSize: 20, Age: 25}, s)
+
+		body := `{"name": "a", "page_size": 500, "tags": ["go", "http", "api"], "nickname": "b"}`
+		s = MyStruct{}
+		err = reqparse.ParseJSON(strings.NewReader(body), &s, opts)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name":      {"must be at least 3 characters long"},
+			"page_size": {"must be less than or equal to 100"},
+			"tags": {
+				"must have at most 2 values",
+				"(Index: 1) must be at most 3 characters long",
+			},
+			"age":      {"field is required"},
+			"nickname": {"must be at least 2 characters long"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name string `json:"name"`
+		}
+
+		testCases := []struct {
+			name        string
+			body        string
+			structError string
+		}{
+			{name: "empty", body: "", structError: "request body must be valid JSON"},
+			{name: "syntax error", body: `{"name":`, structError: "request body must be valid JSON"}, //nolint:lll
+			{name: "trailing data", body: `{} {}`, structError: "request body must be valid JSON"},
+			{name: "array", body: `[]`, structError: "request body must be a JSON object"},
+			{name: "string", body: `"a"`, structError: "request body must be a JSON object"},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				var s MyStruct
+				err := reqparse.ParseJSON(strings.NewReader(tc.body), &s, nil)
+
+				var validationError *reqparse.QueryValidationError
+				require.ErrorAs(t, err, &validationError)
+				assert.Equal(t, []string{tc.structError}, validationError.StructErrors)
+				assert.Equal(t, map[string][]string{}, validationError.FieldErrors)
+			})
+		}
+	})
+
+	t.Run("case insensitive keys", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			UserName string
+			Email    string `json:"email" default:""`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseJSON(
+			strings.NewReader(`{"username": "berk", "EMAIL": "a@b.c"}`), &s, nil,
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{UserName: "berk", Email: "a@b.c"}, s)
+
+		// The first of the keys matching case-insensitively in byte order is used.
+		for i := 0; i < 10; i++ {
+			err = reqparse.ParseJSON(
+				strings.NewReader(`{"uSERNAME": "c", "USERNAME": "a", "userName": "b"}`), &s, nil,
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, "a", s.UserName)
+		}
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name string `json:"name"`
+			Age  int    `json:"age"`
+		}
+
+		s := MyStruct{Name: "initial", Age: 1}
+		err := reqparse.ParseJSON(
+			strings.NewReader(`{"name": "Berk", "age": "x"}`),
+			&s,
+			&reqparse.ParseQueryOptions{Atomic: true},
+		)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, MyStruct{Name: "initial", Age: 1}, s)
+	})
+
+	t.Run("atomic with absent fields", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name *string  `json:"name"`
+			Age  int      `json:"age"  default:"0"`
+			Tags []string `json:"tags"`
+		}
+
+		name := "initial"
+		s := MyStruct{Name: &name, Age: 1, Tags: []string{"a"}}
+		err := reqparse.ParseJSON(
+			strings.NewReader(`{"age": 2}`), &s, &reqparse.ParseQueryOptions{Atomic: true},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: &name, Age: 2, Tags: []string{"a"}}, s)
+
+		err = reqparse.ParseJSON(
+			strings.NewReader(`{}`), &s,
+			&reqparse.ParseQueryOptions{Atomic: true, ErrorOnNoBindableFields: true},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: &name, Age: 2, Tags: []string{"a"}}, s)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name string `json:"name"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseJSON(errReader{}, &s, nil)
+
+		require.EqualError(t, err, "read failed")
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		t.Parallel()
+
+		var s []string
+		err := reqparse.ParseJSON(strings.NewReader(`{}`), &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryTarget)
+	})
+}
@@ -0,0 +1,92 @@
+package reqparse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var errInvalidJSON = newCodedError( //nolint:gochecknoglobals
+	CodeInvalidJSON, "must be valid JSON", nil,
+)
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{}) //nolint:gochecknoglobals
+
+// isJSONEncodedField reports whether the field has `encoding:"json"` tag, i.e. its value is a JSON
+// document decoded into the field with [encoding/json], e.g. `?filter={"min_price":10}`. Fields of
+// any type can be JSON encoded.
+func isJSONEncodedField(structField reflect.StructField) bool {
+	return structField.Tag.Get("encoding") == "json"
+}
+
+// checkEncodingTag returns an error if the `encoding` tag of the field has a value other than
+// "json".
+func checkEncodingTag(structField reflect.StructField) error {
+	encoding, ok := structField.Tag.Lookup("encoding")
+	if ok && encoding != "json" {
+		return fmt.Errorf("%w: encoding:%q (%s)", ErrInvalidTag, encoding, structField.Name)
+	}
+
+	return nil
+}
+
+// castRawMessage casts the value into a json.RawMessage. The value must be a valid JSON document.
+func castRawMessage(value string) (reflect.Value, error) {
+	if !json.Valid([]byte(value)) {
+		return reflect.Value{}, errInvalidJSON
+	}
+
+	return reflect.ValueOf(json.RawMessage(value)), nil
+}
+
+// populateJSON decodes the value of the JSON encoded field selected by the multi-value policy into
+// the field. Pointer, slice and map fields are optional, other fields are required unless they have
+// a default value. Decoding errors of nested values are reported with dotted keys, e.g.
+// "filter.min_price".
+func (p *fieldPlan) populateJSON(
+	fieldv reflect.Value,
+	sourceValues map[string][]string,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) {
+	values, ok := p.applyMultiValuePolicy(sourceValues[p.key], opts, validationErrors)
+	if !ok {
+		return
+	}
+
+	validationErrors.markPresence(p.key, len(values) > 0)
+
+	if len(values) == 0 {
+		switch {
+		case p.hasDefault:
+			values = []string{p.defaultValue}
+		case p.required:
+			validationErrors.addFieldErr(p.key, noIndex, errRequired)
+			return
+		case fieldv.Kind() == reflect.Pointer || fieldv.Kind() == reflect.Slice ||
+			fieldv.Kind() == reflect.Map:
+			fieldv.Set(reflect.Zero(fieldv.Type()))
+			return
+		default:
+			validationErrors.addFieldErr(p.key, noIndex, errRequired)
+			return
+		}
+	}
+
+	decoded := reflect.New(fieldv.Type())
+	if err := json.Unmarshal([]byte(values[0]), decoded.Interface()); err != nil {
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			validationErrors.addFieldErr(p.key, noIndex, errInvalidJSON)
+			return
+		}
+
+		errorKey, fieldErr := jsonFieldError(p.key, fieldv.Type(), err)
+		validationErrors.addFieldErr(errorKey, noIndex, fieldErr)
+
+		return
+	}
+
+	fieldv.Set(decoded.Elem())
+}
@@ -0,0 +1,102 @@
+package reqparse_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryJSONValues(t *testing.T) {
+	t.Parallel()
+
+	type Filter struct {
+		MinPrice int      `json:"min_price"`
+		Colors   []string `json:"colors"`
+	}
+
+	type MyStruct struct {
+		Filter  Filter            `query:"filter"  encoding:"json"`
+		Sort    *Filter           `query:"sort"    encoding:"json"`
+		Labels  map[string]string `query:"labels"  encoding:"json"`
+		Options Filter            `query:"options" encoding:"json" default:"{\"min_price\":5}"`
+		Raw     json.RawMessage   `query:"raw"`
+		Extra   *json.RawMessage  `query:"extra"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"filter": {`{"min_price": 10, "colors": ["red", "blue"]}`},
+			"labels": {`{"team": "core"}`},
+			"raw":    {`[1, {"a": true}]`},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Filter:  Filter{MinPrice: 10, Colors: []string{"red", "blue"}},
+			Labels:  map[string]string{"team": "core"},
+			Options: Filter{MinPrice: 5},
+			Raw:     json.RawMessage(`[1, {"a": true}]`),
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"filter":  {`{"min_price": "10", "colors": ["red", 1]}`},
+			"sort":    {`{"min_price":`},
+			"labels":  {`[]`},
+			"options": {`{"colors": "red"}`},
+			"raw":     {`{a: 1}`},
+			"extra":   {``},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"filter.min_price": {"must be a valid integer"},
+			"sort":             {"must be valid JSON"},
+			"labels":           {"must be a valid object"},
+			"options.colors":   {"must be a valid array"},
+			"raw":              {"must be valid JSON"},
+			"extra":            {"must be valid JSON"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("required field", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"filter": {"field is required"},
+			"raw":    {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid encoding tag", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			Filter string `query:"filter" encoding:"xml"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
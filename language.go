@@ -0,0 +1,155 @@
+package reqparse
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var errInvalidLanguages = newCodedError( //nolint:gochecknoglobals
+	CodeInvalidLanguageList, "must be a valid language list", nil,
+)
+
+var acceptLanguageType = reflect.TypeOf(AcceptLanguage{}) //nolint:gochecknoglobals
+
+// LanguageRange is a language range of the Accept-Language header with its quality value.
+type LanguageRange struct {
+	// Tag is the language range as sent by the client, e.g. "en-US" or "*".
+	Tag string
+
+	// Quality is the q value of the range between 0 and 1. It is 1 if the q value is not present.
+	Quality float64
+}
+
+// AcceptLanguage is the parsed value of an Accept-Language header. It can be used as the type of a
+// field bound with the `header` tag, e.g. `header:"Accept-Language"`; invalid values are reported
+// with "must be a valid language list" validation error.
+type AcceptLanguage struct {
+	// Ranges are the language ranges ordered by their quality values, highest first. Ranges with
+	// the same quality value keep their order in the header.
+	Ranges []LanguageRange
+}
+
+// ParseAcceptLanguage parses the value of an Accept-Language header, e.g.
+// "tr-TR, en;q=0.8, *;q=0.1". It returns [ErrInvalidAcceptLanguage] if the value is malformed.
+func ParseAcceptLanguage(value string) (AcceptLanguage, error) {
+	ranges := make([]LanguageRange, 0)
+
+	for _, element := range splitHeaderList(value, ',') {
+		tag, params, _ := strings.Cut(element, ";")
+
+		languageRange := LanguageRange{Tag: strings.TrimSpace(tag), Quality: 1}
+		if !isValidLanguageRange(languageRange.Tag) {
+			return AcceptLanguage{}, ErrInvalidAcceptLanguage
+		}
+
+		if params != "" {
+			key, qValue, ok := strings.Cut(strings.TrimSpace(params), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(key), "q") {
+				return AcceptLanguage{}, ErrInvalidAcceptLanguage
+			}
+
+			quality, err := strconv.ParseFloat(strings.TrimSpace(qValue), 64)
+			if err != nil || quality < 0 || quality > 1 {
+				return AcceptLanguage{}, ErrInvalidAcceptLanguage
+			}
+
+			languageRange.Quality = quality
+		}
+
+		ranges = append(ranges, languageRange)
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].Quality > ranges[j].Quality
+	})
+
+	return AcceptLanguage{Ranges: ranges}, nil
+}
+
+// isValidLanguageRange reports whether the value is "*" or a language range made of alphanumeric
+// subtags of 1 to 8 characters separated by hyphens, whose first subtag is alphabetic.
+func isValidLanguageRange(value string) bool {
+	if value == "*" {
+		return true
+	}
+
+	for i, subtag := range strings.Split(value, "-") {
+		if len(subtag) == 0 || len(subtag) > 8 {
+			return false
+		}
+
+		for _, r := range subtag {
+			isAlpha := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+			if !isAlpha && (i == 0 || r < '0' || r > '9') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Tags returns the acceptable language ranges in order, excluding the ranges with zero quality.
+func (a AcceptLanguage) Tags() []string {
+	tags := make([]string, 0, len(a.Ranges))
+
+	for _, languageRange := range a.Ranges {
+		if languageRange.Quality > 0 {
+			tags = append(tags, languageRange.Tag)
+		}
+	}
+
+	return tags
+}
+
+// ResolveLanguage returns the supported language that best matches the acceptable language ranges.
+// Ranges are tried in order and a range matches a supported language if they are equal, the
+// supported language starts with the range (e.g. "en" matches "en-US"), or the range starts with
+// the supported language (e.g. "en-US" matches "en"). "*" matches the first supported language.
+// Comparisons are case-insensitive. It returns false if none of the supported languages matches.
+func (a AcceptLanguage) ResolveLanguage(supported []string) (string, bool) {
+	for _, tag := range a.Tags() {
+		if tag == "*" && len(supported) > 0 {
+			return supported[0], true
+		}
+
+		if language, ok := matchLanguage(tag, supported); ok {
+			return language, true
+		}
+	}
+
+	return "", false
+}
+
+// matchLanguage returns the supported language matching the language range. Exact matches are
+// preferred, then the more specific supported languages, then the less specific ones.
+func matchLanguage(tag string, supported []string) (string, bool) {
+	for _, language := range supported {
+		if strings.EqualFold(language, tag) {
+			return language, true
+		}
+	}
+
+	for _, language := range supported {
+		if hasLanguagePrefix(language, tag) {
+			return language, true
+		}
+	}
+
+	for _, language := range supported {
+		if hasLanguagePrefix(tag, language) {
+			return language, true
+		}
+	}
+
+	return "", false
+}
+
+// hasLanguagePrefix reports whether the language tag starts with the subtags of the prefix, e.g.
+// "en-US" starts with "en".
+func hasLanguagePrefix(tag string, prefix string) bool {
+	return len(tag) > len(prefix) && tag[len(prefix)] == '-' &&
+		strings.EqualFold(tag[:len(prefix)], prefix)
+}
@@ -0,0 +1,131 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected []string
+		err      error
+	}{
+		{
+			name:     "ordered by quality",
+			value:    "en;q=0.8, tr-TR, *;q=0.1, de;q=0.8",
+			expected: []string{"tr-TR", "en", "de", "*"},
+		},
+		{name: "zero quality excluded", value: "fr;q=0, en", expected: []string{"en"}},
+		{name: "empty", value: "", expected: []string{}},
+		{name: "script subtag", value: "zh-Hant-TW;Q=1.0", expected: []string{"zh-Hant-TW"}},
+		{name: "invalid tag", value: "en_US", err: reqparse.ErrInvalidAcceptLanguage},
+		{name: "long subtag", value: "en-abcdefghi", err: reqparse.ErrInvalidAcceptLanguage},
+		{name: "invalid quality", value: "en;q=high", err: reqparse.ErrInvalidAcceptLanguage},
+		{name: "quality out of range", value: "en;q=2", err: reqparse.ErrInvalidAcceptLanguage},
+		{name: "unknown param", value: "en;level=1", err: reqparse.ErrInvalidAcceptLanguage},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			acceptLanguage, err := reqparse.ParseAcceptLanguage(tc.value)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, acceptLanguage.Tags())
+		})
+	}
+}
+
+func TestAcceptLanguageResolveLanguage(t *testing.T) {
+	t.Parallel()
+
+	supported := []string{"en-US", "en-GB", "tr", "de-DE"}
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected string
+		ok       bool
+	}{
+		{name: "exact match", value: "en-GB, en-US;q=0.9", expected: "en-GB", ok: true},
+		{name: "case-insensitive", value: "TR", expected: "tr", ok: true},
+		{name: "more specific supported", value: "en", expected: "en-US", ok: true},
+		{name: "less specific supported", value: "tr-TR", expected: "tr", ok: true},
+		{name: "preference order", value: "fr, de;q=0.5, tr;q=0.4", expected: "de-DE", ok: true},
+		{name: "wildcard", value: "fr, *;q=0.1", expected: "en-US", ok: true},
+		{name: "zero quality", value: "tr;q=0", ok: false},
+		{name: "no match", value: "fr, es", ok: false},
+		{name: "prefix is not a subtag", value: "e", ok: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			acceptLanguage, err := reqparse.ParseAcceptLanguage(tc.value)
+			require.NoError(t, err)
+
+			language, ok := acceptLanguage.ResolveLanguage(supported)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, language)
+		})
+	}
+}
+
+func TestAcceptLanguageHeaderField(t *testing.T) {
+	t.Parallel()
+
+	type headers struct {
+		Languages reqparse.AcceptLanguage `header:"Accept-Language" default:"en"`
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Accept-Language", "tr;q=0.5, en-US")
+
+		var h headers
+		require.NoError(t, reqparse.ParseHeader(header, &h, nil))
+		assert.Equal(t, []string{"en-US", "tr"}, h.Languages.Tags())
+	})
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		var h headers
+		require.NoError(t, reqparse.ParseHeader(http.Header{}, &h, nil))
+		assert.Equal(t, []string{"en"}, h.Languages.Tags())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Accept-Language", "en;q=x")
+
+		var h headers
+		err := reqparse.ParseHeader(header, &h, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"Accept-Language": {"must be a valid language list"},
+		}, validationErr.FieldErrors)
+	})
+}
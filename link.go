@@ -0,0 +1,108 @@
+package reqparse
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Link is a link of the Link header, e.g. `<https://api.example.com/items?page=2>; rel="next"`.
+type Link struct {
+	// URL is the target of the link as it appears in the header. Relative references are not
+	// resolved.
+	URL *url.URL
+
+	// Rel is the relation type the link is registered for in [Links], lower cased.
+	Rel string
+
+	// Params are the other parameters of the link, e.g. "title" or "type", with lower cased keys.
+	// Parameters without a value have empty values.
+	Params map[string]string
+}
+
+// Links are the links of the Link header by their relation types.
+type Links map[string]Link
+
+// ParseLinks parses the RFC 8288 Link headers of the header, e.g. the response headers of a
+// paginated API. Links are returned by their relation types, so the next page can be accessed with
+// links["next"]. A link with multiple relation types, e.g. rel="next last", is registered for each
+// of them; if multiple links have the same relation type, the first one is used. Links without a
+// relation type are ignored. Multiple Link headers are parsed as one comma separated list.
+//
+// It returns [ErrInvalidLink] if a header is malformed. If there is no Link header, the returned
+// map is empty.
+func ParseLinks(header http.Header) (Links, error) {
+	links := make(Links)
+
+	for _, element := range splitHeaderList(strings.Join(header.Values("Link"), ","), ',') {
+		link, rels, ok := parseLink(element)
+		if !ok {
+			return nil, ErrInvalidLink
+		}
+
+		for _, rel := range rels {
+			if _, exists := links[rel]; !exists {
+				link.Rel = rel
+				links[rel] = link
+			}
+		}
+	}
+
+	return links, nil
+}
+
+// parseLink parses a link-value of the Link header, e.g. `<https://example.com>; rel="next"`, and
+// returns the link with its relation types. It returns false if the link-value is malformed.
+func parseLink(element string) (Link, []string, bool) {
+	end := strings.IndexByte(element, '>')
+	if !strings.HasPrefix(element, "<") || end < 0 {
+		return Link{}, nil, false
+	}
+
+	linkURL, err := url.Parse(strings.TrimSpace(element[1:end]))
+	if err != nil {
+		return Link{}, nil, false
+	}
+
+	params := splitHeaderList(element[end+1:], ';')
+	if rest := strings.TrimSpace(element[end+1:]); rest != "" && !strings.HasPrefix(rest, ";") {
+		return Link{}, nil, false
+	}
+
+	link := Link{URL: linkURL, Params: make(map[string]string, len(params))}
+
+	var (
+		rels     []string
+		relFound bool
+	)
+
+	for _, param := range params {
+		key, value, _ := strings.Cut(param, "=")
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key == "" {
+			return Link{}, nil, false
+		}
+
+		value, ok := unquoteHeaderValue(strings.TrimSpace(value))
+		if !ok {
+			return Link{}, nil, false
+		}
+
+		if key != "rel" {
+			if _, exists := link.Params[key]; !exists {
+				link.Params[key] = value
+			}
+
+			continue
+		}
+
+		// Occurrences of the rel parameter after the first one are ignored.
+		if !relFound {
+			rels = strings.Fields(strings.ToLower(value))
+			relFound = true
+		}
+	}
+
+	return link, rels, true
+}
@@ -0,0 +1,91 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLinks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pagination", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Add("Link", `<https://api.example.com/items?page=2&tags=a,b>; rel="next", `+
+			`<https://api.example.com/items?page=9>; rel="last"; title="Last page"`)
+		header.Add("Link", `</items?page=1>; REL="prev first"`)
+
+		links, err := reqparse.ParseLinks(header)
+		require.NoError(t, err)
+		require.Len(t, links, 4)
+
+		assert.Equal(t, "https://api.example.com/items?page=2&tags=a,b", links["next"].URL.String())
+		assert.Equal(t, "next", links["next"].Rel)
+		assert.Equal(t, "Last page", links["last"].Params["title"])
+		assert.Equal(t, "/items?page=1", links["prev"].URL.String())
+		assert.Equal(t, "first", links["first"].Rel)
+		assert.Equal(t, links["prev"].URL, links["first"].URL)
+	})
+
+	t.Run("params", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Link", `<https://example.com/a;b>; rel=next; crossorigin; `+
+			`title="a; \"quoted\", title"; rel=ignored; title=second`)
+
+		links, err := reqparse.ParseLinks(header)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+
+		assert.Equal(t, "https://example.com/a;b", links["next"].URL.String())
+		assert.Equal(t, map[string]string{
+			"crossorigin": "",
+			"title":       `a; "quoted", title`,
+		}, links["next"].Params)
+	})
+
+	t.Run("first link of a relation type wins", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Link", `</a>; rel=next, </b>; rel=next, </c>`)
+
+		links, err := reqparse.ParseLinks(header)
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, "/a", links["next"].URL.String())
+	})
+
+	t.Run("no link header", func(t *testing.T) {
+		t.Parallel()
+
+		links, err := reqparse.ParseLinks(http.Header{})
+		require.NoError(t, err)
+		assert.Empty(t, links)
+	})
+
+	for name, value := range map[string]string{
+		"missing brackets":    `https://example.com; rel=next`,
+		"unterminated target": `<https://example.com; rel=next`,
+		"invalid url":         `<http://[::1>; rel=next`,
+		"missing separator":   `<https://example.com> rel=next`,
+		"unterminated quote":  `<https://example.com>; rel="next`,
+	} {
+		value := value
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			header := http.Header{}
+			header.Set("Link", value)
+
+			_, err := reqparse.ParseLinks(header)
+			require.ErrorIs(t, err, reqparse.ErrInvalidLink)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package reqparse
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// MapKeyStyle is the convention used for the query parameter names of map fields.
+type MapKeyStyle string
+
+const (
+	// MapKeyStyleBracket collects `meta[color]=red&meta[size]=large` query parameters into a map
+	// field tagged with `query:"meta"`. It is the default style.
+	MapKeyStyleBracket MapKeyStyle = "bracket"
+
+	// MapKeyStyleDot collects `meta.color=red&meta.size=large` query parameters into a map field
+	// tagged with `query:"meta"`.
+	MapKeyStyleDot MapKeyStyle = "dot"
+)
+
+// styleDeepObject is the value of the `style` tag that selects the bracket notation of the OpenAPI
+// deepObject style, e.g. `meta[color]=red`, regardless of [ParseQueryOptions.MapKeyStyle].
+const styleDeepObject = "deepObject"
+
+// populateMapFieldFromQuery collects the query params whose names match the map key style for the
+// field and sets them into the map field. Only the first value of each query param is used.
+// Validation errors of the map values are reported with the query param name as the key.
+func populateMapFieldFromQuery(
+	fieldv reflect.Value,
+	structField reflect.StructField,
+	fieldKey string,
+	queryParams map[string][]string,
+	style MapKeyStyle,
+	castOpts castOptions,
+	validationErrors *QueryValidationError,
+) {
+	mapValues := mapQueryParams(queryParams, fieldKey, style)
+	if len(mapValues) == 0 && isRequiredField(structField) {
+		validationErrors.addFieldErr(fieldKey, noIndex, errRequired)
+		return
+	}
+
+	// Iterate in sorted order so the validation errors are recorded deterministically.
+	queryKeys := make([]string, 0, len(mapValues))
+	for queryKey := range mapValues { //nolint:wsl
+		queryKeys = append(queryKeys, queryKey)
+	}
+	sort.Strings(queryKeys) //nolint:wsl
+
+	newMap := reflect.MakeMapWithSize(fieldv.Type(), len(mapValues))
+
+	for _, queryKey := range queryKeys {
+		entry := mapValues[queryKey]
+
+		castedValue, err := castQueryValue(fieldv.Type().Elem(), entry.value, castOpts)
+		if err != nil {
+			validationErrors.addFieldErr(queryKey, noIndex, err)
+			continue
+		}
+
+		newMap.SetMapIndex(reflect.ValueOf(entry.mapKey).Convert(fieldv.Type().Key()), castedValue)
+	}
+
+	fieldv.Set(newMap)
+}
+
+// mapQueryParam is a query param that belongs to a map field.
+type mapQueryParam struct {
+	mapKey string
+	value  string
+}
+
+// mapQueryParams returns the query params that belong to the map field with the given query key,
+// keyed by the query param names.
+func mapQueryParams(
+	queryParams map[string][]string,
+	fieldKey string,
+	style MapKeyStyle,
+) map[string]mapQueryParam {
+	result := make(map[string]mapQueryParam)
+
+	for queryKey, values := range queryParams {
+		if len(values) == 0 {
+			continue
+		}
+
+		mapKey, ok := mapKeyFromQueryKey(queryKey, fieldKey, style)
+		if !ok {
+			continue
+		}
+
+		result[queryKey] = mapQueryParam{mapKey: mapKey, value: values[0]}
+	}
+
+	return result
+}
+
+// mapKeyFromQueryKey extracts the map key from the query param name, e.g. "color" from
+// "meta[color]" or "meta.color" depending on the style. It returns false if the query param
+// doesn't belong to the map field.
+func mapKeyFromQueryKey(queryKey, fieldKey string, style MapKeyStyle) (string, bool) {
+	if style == MapKeyStyleDot {
+		prefix := fieldKey + "."
+		if len(queryKey) <= len(prefix) || !strings.HasPrefix(queryKey, prefix) {
+			return "", false
+		}
+
+		return queryKey[len(prefix):], true
+	}
+
+	prefix := fieldKey + "["
+	if len(queryKey) <= len(prefix)+1 || !strings.HasPrefix(queryKey, prefix) ||
+		!strings.HasSuffix(queryKey, "]") {
+		return "", false
+	}
+
+	mapKey := queryKey[len(prefix) : len(queryKey)-1]
+	if strings.ContainsAny(mapKey, "[]") {
+		return "", false
+	}
+
+	return mapKey, true
+}
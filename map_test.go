@@ -0,0 +1,192 @@
+package reqparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryMapFields(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Meta   map[string]string `query:"meta"`
+		Labels map[string]string `query:"labels"`
+	}
+
+	inputQueryParams := map[string][]string{
+		"meta[color]":     {"red", "blue"},
+		"meta[size]":      {"large"},
+		"meta.weight":     {"heavy"},
+		"meta[a][b]":      {"nested"},
+		"meta[]":          {"empty"},
+		"metadata[color]": {"green"},
+		"labels.env":      {"prod"},
+		"labels.team":     {"core"},
+		"labels.":         {"empty"},
+		"labels[app]":     {"api"},
+		"meta":            {"plain"},
+	}
+
+	t.Run("bracket style", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Meta:   map[string]string{"color": "red", "size": "large"},
+			Labels: map[string]string{"app": "api"},
+		}, s)
+
+		err = reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{MapKeyStyle: reqparse.MapKeyStyleBracket},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Meta:   map[string]string{"color": "red", "size": "large"},
+			Labels: map[string]string{"app": "api"},
+		}, s)
+	})
+
+	t.Run("dot style", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{MapKeyStyle: reqparse.MapKeyStyleDot},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Meta:   map[string]string{"weight": "heavy"},
+			Labels: map[string]string{"env": "prod", "team": "core"},
+		}, s)
+	})
+
+	t.Run("absent and required", func(t *testing.T) {
+		t.Parallel()
+
+		type RequiredStruct struct {
+			Meta   map[string]string `query:"meta"   required:"true"`
+			Labels map[string]string `query:"labels"`
+		}
+
+		var s RequiredStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"meta": {"field is required"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, map[string]string{}, s.Labels)
+	})
+
+	t.Run("invalid map key style", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{MapKeyStyle: "dots"},
+		)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidOption)
+		assert.EqualError(t, err, `invalid parse option: MapKeyStyle "dots"`)
+	})
+
+	t.Run("typed map values", func(t *testing.T) {
+		t.Parallel()
+
+		type TypedStruct struct {
+			Limits map[string]int           `query:"limits"`
+			Flags  map[string]bool          `query:"flags"`
+			Delays map[string]time.Duration `query:"delays"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"limits[users]":  {"10"},
+			"limits[orders]": {"x"},
+			"flags[beta]":    {"true"},
+			"delays[retry]":  {"5s"},
+		}
+
+		var s TypedStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"limits[orders]": {"must be a valid integer"},
+		}, validationErr.FieldErrors)
+
+		delete(inputQueryParams, "limits[orders]")
+
+		err = reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+		assert.Equal(t, TypedStruct{
+			Limits: map[string]int{"users": 10},
+			Flags:  map[string]bool{"beta": true},
+			Delays: map[string]time.Duration{"retry": 5 * time.Second},
+		}, s)
+	})
+
+	t.Run("deep object style tag", func(t *testing.T) {
+		t.Parallel()
+
+		type StyleStruct struct {
+			Meta   map[string]string `query:"meta"   style:"deepObject"`
+			Labels map[string]string `query:"labels"`
+		}
+
+		var s StyleStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{MapKeyStyle: reqparse.MapKeyStyleDot},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, StyleStruct{
+			Meta:   map[string]string{"color": "red", "size": "large"},
+			Labels: map[string]string{"env": "prod", "team": "core"},
+		}, s)
+	})
+
+	t.Run("invalid style tag", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			Meta map[string]string `query:"meta" style:"matrix"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+
+	t.Run("invalid map type", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			Meta map[int]string `query:"meta"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+	})
+}
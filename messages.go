@@ -0,0 +1,267 @@
+package reqparse
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// ErrorCode is the machine-readable code of a validation error, e.g. "required" or
+// "invalid_integer". Codes are stable, unlike the messages, which may change between versions.
+type ErrorCode string
+
+// Codes of the validation errors reported by the package. The parameters of the errors, which can
+// be used in the templates of a [MessageCatalog], are listed for the codes that have any.
+const (
+	// CodeInvalid is the code of the errors without a code of their own, e.g. the errors of custom
+	// rules, converters and [QueryValueValidator] values.
+	CodeInvalid ErrorCode = "invalid"
+
+	CodeRequired               ErrorCode = "required"
+	CodeInvalidInteger         ErrorCode = "invalid_integer"
+	CodeInvalidUnsignedInteger ErrorCode = "invalid_unsigned_integer"
+	CodeInvalidFloat           ErrorCode = "invalid_float"
+	CodeInvalidBoolean         ErrorCode = "invalid_boolean"
+	CodeInvalidString          ErrorCode = "invalid_string"
+	CodeInvalidArray           ErrorCode = "invalid_array"
+	CodeInvalidObject          ErrorCode = "invalid_object"
+	CodeInvalidValue           ErrorCode = "invalid_value"
+	CodeInvalidCharacters      ErrorCode = "invalid_characters"
+	CodeInvalidJSON            ErrorCode = "invalid_json"
+	CodeInvalidURL             ErrorCode = "invalid_url"
+	CodeInvalidUUID            ErrorCode = "invalid_uuid"
+	CodeInvalidIPAddress       ErrorCode = "invalid_ip_address"
+	CodeInvalidIPPrefix        ErrorCode = "invalid_ip_prefix"
+	CodeInvalidRange           ErrorCode = "invalid_range"
+	CodeInvalidLanguageList    ErrorCode = "invalid_language_list"
+	CodeMissingValue           ErrorCode = "missing_value"
+	CodeMultipleValues         ErrorCode = "multiple_values"
+	CodeNotUnique              ErrorCode = "not_unique"
+	CodePattern                ErrorCode = "pattern"
+
+	// CodeInvalidDate has the "format" parameter if the field has a `timeformat` tag.
+	CodeInvalidDate ErrorCode = "invalid_date"
+
+	// CodeInvalidDuration has the "unit" parameter if the field has a `durationunit` tag.
+	CodeInvalidDuration ErrorCode = "invalid_duration"
+
+	// CodeInvalidAuthorization has the "scheme" parameter.
+	CodeInvalidAuthorization ErrorCode = "invalid_authorization"
+
+	// CodeInvalidFormat has the "format" parameter, e.g. "email".
+	CodeInvalidFormat ErrorCode = "invalid_format"
+
+	// CodeOutOfRange has the "min" and "max" parameters.
+	CodeOutOfRange ErrorCode = "out_of_range"
+
+	// CodeMin and CodeMinLength have the "min" parameter, and CodeMax and CodeMaxLength have the
+	// "max" parameter.
+	CodeMin       ErrorCode = "min"
+	CodeMax       ErrorCode = "max"
+	CodeMinLength ErrorCode = "min_length"
+	CodeMaxLength ErrorCode = "max_length"
+
+	// CodeLength has the "length" parameter.
+	CodeLength ErrorCode = "length"
+
+	// CodeMultipleOf has the "multiple" parameter.
+	CodeMultipleOf ErrorCode = "multiple_of"
+
+	// CodeOneOf has the "allowed" parameter.
+	CodeOneOf ErrorCode = "one_of"
+
+	// CodeAfter and CodeBefore have the "time" parameter, e.g. "now+30d".
+	CodeAfter  ErrorCode = "after"
+	CodeBefore ErrorCode = "before"
+
+	// CodeMinItems has the "min" parameter and CodeMaxItems has the "max" parameter.
+	CodeMinItems ErrorCode = "min_items"
+	CodeMaxItems ErrorCode = "max_items"
+
+	// CodeGtField, CodeGteField, CodeLtField and CodeLteField are the codes of the struct errors of
+	// the cross-field constraints. They have the "field" and "other" parameters, which are the keys
+	// of the compared fields.
+	CodeGtField  ErrorCode = "gtfield"
+	CodeGteField ErrorCode = "gtefield"
+	CodeLtField  ErrorCode = "ltfield"
+	CodeLteField ErrorCode = "ltefield"
+)
+
+// codedError is a validation error with a code and the parameters of its message.
+type codedError struct {
+	code    ErrorCode
+	message string
+	params  map[string]any
+}
+
+// newCodedError creates a validation error with the code, the default message and the parameters
+// of the message.
+func newCodedError(code ErrorCode, message string, params map[string]any) error {
+	return &codedError{code: code, message: message, params: params}
+}
+
+func (e *codedError) Error() string {
+	return e.message
+}
+
+// errorCodeOf returns the code and the parameters of the validation error. Errors without a code
+// have the [CodeInvalid] code.
+func errorCodeOf(err error) (ErrorCode, map[string]any) {
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code, coded.params
+	}
+
+	return CodeInvalid, nil
+}
+
+// MessageCatalog holds the message templates of the validation errors for each language, e.g.
+// catalog["tr"][reqparse.CodeRequired] = "alan zorunludur". Languages are language tags like the
+// ones of the Accept-Language header, e.g. "tr" or "pt-BR". Templates can contain the parameters
+// of the errors in braces, e.g. "{min} veya daha büyük olmalıdır" for [CodeMin]. Errors whose
+// codes have no template in the language keep their default English messages.
+type MessageCatalog map[string]map[ErrorCode]string
+
+// Language returns the language of the catalog that best matches the Accept-Language header of
+// the request, see [AcceptLanguage.ResolveLanguage]. It returns an empty string if the header is
+// absent or malformed, or none of the languages matches it.
+func (c MessageCatalog) Language(r *http.Request) string {
+	acceptLanguage, err := ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	if err != nil {
+		return ""
+	}
+
+	language, _ := acceptLanguage.ResolveLanguage(c.languages())
+
+	return language
+}
+
+// languages returns the languages of the catalog in sorted order.
+func (c MessageCatalog) languages() []string {
+	languages := make([]string, 0, len(c))
+	for language := range c { //nolint:wsl
+		languages = append(languages, language)
+	}
+	sort.Strings(languages) //nolint:wsl
+
+	return languages
+}
+
+// message returns the message of the error code in the language with the parameters filled in. A
+// language without templates falls back to a matching language of the catalog, e.g. "en-US" to
+// "en". It returns false if there is no template for the code.
+func (c MessageCatalog) message(
+	language string,
+	code ErrorCode,
+	params map[string]any,
+) (string, bool) {
+	templates, ok := c[language]
+	if !ok {
+		matched, found := matchLanguage(language, c.languages())
+		if !found {
+			return "", false
+		}
+
+		templates = c[matched]
+	}
+
+	template, ok := templates[code]
+	if !ok {
+		return "", false
+	}
+
+	return fillMessageTemplate(template, params), true
+}
+
+// fillMessageTemplate replaces the parameters in braces in the template with their values, e.g.
+// "{min}" with the value of the "min" parameter. Unknown parameters are left as is.
+func fillMessageTemplate(template string, params map[string]any) string {
+	if len(params) == 0 || !strings.Contains(template, "{") {
+		return template
+	}
+
+	var replacements []string
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", formatMessageParam(value))
+	}
+
+	return strings.NewReplacer(replacements...).Replace(template)
+}
+
+// formatMessageParam formats the value of a message parameter: floats without trailing zeros and
+// string lists separated by commas.
+func formatMessageParam(value any) string {
+	switch v := value.(type) {
+	case float64:
+		return formatFloat(v)
+	case []string:
+		return strings.Join(v, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// localizeMessage returns the message of the validation error of the field with the code and the
+// parameters: the message of [ParseQueryOptions.MessageFunc] if it isn't empty, otherwise the
+// template of the message catalog in the language of the options or the default message. custom is
+// true for the messages of MessageFunc.
+func (o *ParseQueryOptions) localizeMessage(
+	fieldKey string,
+	index int,
+	code ErrorCode,
+	params map[string]any,
+	defaultMessage string,
+) (message string, custom bool) {
+	if o.MessageFunc != nil {
+		var funcParams map[string]any
+		if index == noIndex {
+			funcParams = cloneParams(params, 0)
+		} else {
+			funcParams = cloneParams(params, 1)
+			funcParams["index"] = index
+		}
+
+		if message := o.MessageFunc(fieldKey, code, funcParams); message != "" {
+			return message, true
+		}
+	}
+
+	if o.MessageCatalog != nil && o.Language != "" {
+		if message, ok := o.MessageCatalog.message(o.Language, code, params); ok {
+			return message, false
+		}
+	}
+
+	return defaultMessage, false
+}
+
+// cloneParams returns a copy of the parameters of an error with room for extra parameters. It
+// returns nil if there are no parameters and no room is needed.
+func cloneParams(params map[string]any, extra int) map[string]any {
+	if len(params) == 0 && extra == 0 {
+		return nil
+	}
+
+	clone := make(map[string]any, len(params)+extra)
+	for name, value := range params { //nolint:wsl
+		clone[name] = value
+	}
+
+	return clone
+}
+
+// requestOptions returns the options for parsing the request. If the options have a message
+// catalog but no language, the language is resolved from the Accept-Language header of the
+// request, see [MessageCatalog.Language].
+func (o *ParseQueryOptions) requestOptions(r *http.Request) *ParseQueryOptions {
+	if o.MessageCatalog == nil || o.Language != "" {
+		return o
+	}
+
+	opts := *o
+	opts.Language = o.MessageCatalog.Language(r)
+
+	return &opts
+}
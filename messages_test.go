@@ -0,0 +1,273 @@
+package reqparse_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryMessageCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := reqparse.MessageCatalog{
+		"tr": {
+			reqparse.CodeRequired:       "alan zorunludur",
+			reqparse.CodeInvalidInteger: "geçerli bir tam sayı olmalıdır",
+			reqparse.CodeMin:            "{min} veya daha büyük olmalıdır",
+			reqparse.CodeOneOf:          "şunlardan biri olmalıdır: {allowed}",
+			reqparse.CodeGtField:        "{field}, {other} değerinden büyük olmalıdır",
+		},
+		"de": {
+			reqparse.CodeRequired: "Feld ist erforderlich",
+		},
+	}
+
+	type MyStruct struct {
+		Query    string   `query:"q"`
+		Page     int      `query:"page"      validate:"min=1"`
+		IDs      []int    `query:"id"`
+		Order    string   `query:"order"     validate:"oneof=asc desc"`
+		Name     string   `query:"name"      validate:"max=3"`
+		MinPrice *float64 `query:"min_price"`
+		MaxPrice *float64 `query:"max_price" validate:"gtfield=MinPrice"`
+	}
+
+	inputQueryParams := map[string][]string{
+		"page":  {"0"},
+		"id":    {"1", "x"},
+		"order": {"random"},
+		"name":  {"long name"},
+	}
+
+	t.Run("localized messages", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			MessageCatalog: catalog,
+			Language:       "tr",
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"q":     {"alan zorunludur"},
+			"page":  {"1 veya daha büyük olmalıdır"},
+			"id":    {"(Index: 1) geçerli bir tam sayı olmalıdır"},
+			"order": {"şunlardan biri olmalıdır: asc, desc"},
+			"name":  {"must be at most 3 characters long"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("matching language", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			MessageCatalog: catalog,
+			Language:       "de-AT",
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"Feld ist erforderlich"}, validationErr.FieldErrors["q"])
+		assert.Equal(t, []string{"must be greater than or equal to 1"},
+			validationErr.FieldErrors["page"])
+	})
+
+	t.Run("default messages", func(t *testing.T) {
+		t.Parallel()
+
+		for _, language := range []string{"", "fr"} {
+			var s MyStruct
+			err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+				MessageCatalog: catalog,
+				Language:       language,
+			})
+
+			var validationErr *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, []string{"field is required"}, validationErr.FieldErrors["q"])
+		}
+	})
+
+	t.Run("cross-field constraints", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{
+			"q":         {"shoes"},
+			"order":     {"asc"},
+			"min_price": {"20"},
+			"max_price": {"10"},
+		}, &s, &reqparse.ParseQueryOptions{
+			MessageCatalog: catalog,
+			Language:       "tr",
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"max_price, min_price değerinden büyük olmalıdır"},
+			validationErr.StructErrors)
+	})
+}
+
+func TestParseRequestMessageCatalog(t *testing.T) {
+	t.Parallel()
+
+	catalog := reqparse.MessageCatalog{
+		"en": {reqparse.CodeRequired: "is required"},
+		"tr": {reqparse.CodeRequired: "alan zorunludur"},
+	}
+
+	type MyStruct struct {
+		Page int `query:"page"`
+	}
+
+	testCases := map[string]struct {
+		acceptLanguage  string
+		language        string
+		expectedMessage string
+	}{
+		"accept-language": {
+			acceptLanguage:  "tr-TR, en;q=0.8",
+			expectedMessage: "alan zorunludur",
+		},
+		"lower quality": {
+			acceptLanguage:  "fr, en;q=0.8, tr;q=0.5",
+			expectedMessage: "is required",
+		},
+		"no matching language": {
+			acceptLanguage:  "fr",
+			expectedMessage: "field is required",
+		},
+		"language option": {
+			acceptLanguage:  "tr",
+			language:        "en",
+			expectedMessage: "is required",
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Language", tc.acceptLanguage)
+
+			var s MyStruct
+			err := reqparse.ParseRequest(r, &s, &reqparse.ParseQueryOptions{
+				MessageCatalog: catalog,
+				Language:       tc.language,
+			})
+
+			var validationErr *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, []string{tc.expectedMessage}, validationErr.FieldErrors["page"])
+		})
+	}
+}
+
+func TestMessageCatalogLanguage(t *testing.T) {
+	t.Parallel()
+
+	catalog := reqparse.MessageCatalog{"en": {}, "pt-BR": {}, "tr": {}}
+
+	testCases := map[string]string{
+		"":                 "",
+		"pt":               "pt-BR",
+		"TR-tr":            "tr",
+		"fr, *;q=0.1":      "en",
+		"en;q=0, tr;q=0.5": "tr",
+		"invalid;;":        "",
+	}
+
+	for acceptLanguage, expected := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Language", acceptLanguage)
+
+		assert.Equal(t, expected, catalog.Language(r), acceptLanguage)
+	}
+}
+
+func TestParseQueryMessageFunc(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Page     int      `query:"page"      validate:"min=1"`
+		IDs      []int    `query:"id"`
+		Name     string   `query:"name"      validate:"max=3"`
+		MinPrice *float64 `query:"min_price"`
+		MaxPrice *float64 `query:"max_price" validate:"gtfield=MinPrice"`
+	}
+
+	messageFunc := func(fieldKey string, code reqparse.ErrorCode, params map[string]any) string {
+		switch code { //nolint:exhaustive
+		case reqparse.CodeMin:
+			return fmt.Sprintf("%s: at least %v", fieldKey, params["min"])
+		case reqparse.CodeInvalidInteger:
+			return fmt.Sprintf("%s[%v]: not an integer", fieldKey, params["index"])
+		case reqparse.CodeGtField:
+			return fmt.Sprintf("%q: %v > %v", fieldKey, params["field"], params["other"])
+		default:
+			return ""
+		}
+	}
+
+	inputQueryParams := map[string][]string{
+		"page":      {"0"},
+		"id":        {"1", "x"},
+		"name":      {"long name"},
+		"min_price": {"20"},
+		"max_price": {"10"},
+	}
+
+	t.Run("custom messages", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			MessageFunc: messageFunc,
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page": {"page: at least 1"},
+			"id":   {"id[1]: not an integer"},
+			"name": {"must be at most 3 characters long"},
+		}, validationErr.FieldErrors)
+		assert.Equal(t, []string{`"": max_price > min_price`}, validationErr.StructErrors)
+	})
+
+	t.Run("message catalog fallback", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			MessageFunc: messageFunc,
+			MessageCatalog: reqparse.MessageCatalog{
+				"tr": {
+					reqparse.CodeMin:       "{min} veya daha büyük olmalıdır",
+					reqparse.CodeMaxLength: "en fazla {max} karakter olmalıdır",
+				},
+			},
+			Language: "tr",
+		})
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page": {"page: at least 1"},
+			"id":   {"id[1]: not an integer"},
+			"name": {"en fazla 3 karakter olmalıdır"},
+		}, validationErr.FieldErrors)
+	})
+}
@@ -0,0 +1,123 @@
+package reqparse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// contextKey is the key of the parsed requests of type T in the request context.
+type contextKey[T any] struct{}
+
+// Middleware returns a middleware that parses each request into a new T with [ParseRequest] and
+// stores it in the request context, to be retrieved by the handlers with [FromContext]. T must be
+// a struct type.
+//
+// If the request doesn't satisfy the validation rules of T, the next handler is not called and a
+// 400 Bad Request response is written with a JSON body containing the field errors and the struct
+// errors:
+//
+//	{"field_errors": {"page": ["must be a valid integer"]}, "struct_errors": []}
+//
+// Set the ErrorResponse option to write a different response. Other errors, e.g. invalid struct
+// tags, are reported with an empty 500 Internal Server Error response. If options are nil, default
+// options are used.
+func Middleware[T any](opts *ParseQueryOptions) func(next http.Handler) http.Handler {
+	parser := NewParser(opts)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var target T
+			if !parseOrWriteError(parser, w, r, &target) {
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), contextKey[T]{}, target)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Handler returns a handler that parses each request into a new T with [ParseRequest] and calls fn
+// with it. T must be a struct type. fn is called only if parsing succeeds; otherwise the error
+// response is written the same way as [Middleware]. If options are nil, default options are used.
+func Handler[T any](
+	fn func(w http.ResponseWriter, r *http.Request, params T),
+	opts *ParseQueryOptions,
+) http.Handler {
+	parser := NewParser(opts)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params T
+		if parseOrWriteError(parser, w, r, &params) {
+			fn(w, r, params)
+		}
+	})
+}
+
+// FromContext returns the request of type T stored in the context by [Middleware]. It returns false
+// if the context has no request of type T.
+func FromContext[T any](ctx context.Context) (T, bool) {
+	target, ok := ctx.Value(contextKey[T]{}).(T)
+	return target, ok
+}
+
+// BindQuery parses the query parameters of the request into given struct the same way as
+// ParseQuery(r.URL.Query(), ...). The language of the MessageCatalog option is selected from the
+// request like the other request parsers do. If parsing fails, the error response is written the
+// same way as [Middleware] and false is returned, so handlers can return early:
+//
+//	var params ListItemsParams
+//	if !reqparse.BindQuery(w, r, &params, nil) {
+//		return
+//	}
+//
+// If options are nil, default options are used.
+func BindQuery(w http.ResponseWriter, r *http.Request, target any, opts *ParseQueryOptions) bool {
+	parser := NewParser(opts)
+	requestOpts := parser.opts.requestOptions(r)
+
+	return writeParseError(
+		w, r, parseValues(r.URL.Query(), target, querySource, requestOpts), requestOpts,
+	)
+}
+
+// parseOrWriteError parses the request into the target with the parser. If parsing fails, the
+// error response is written and false is returned.
+func parseOrWriteError(parser *Parser, w http.ResponseWriter, r *http.Request, target any) bool {
+	return writeParseError(w, r, parser.ParseRequest(r, target), &parser.opts)
+}
+
+// writeParseError writes the error response of the parsing error and returns false, or returns
+// true if there is no error. Validation errors are written with the ErrorResponse option or as a
+// 400 Bad Request JSON response, and other errors with an empty 500 Internal Server Error
+// response.
+func writeParseError(
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+	opts *ParseQueryOptions,
+) bool {
+	if err == nil {
+		return true
+	}
+
+	var validationErr *QueryValidationError
+	if !errors.As(err, &validationErr) {
+		w.WriteHeader(http.StatusInternalServerError)
+		return false
+	}
+
+	if opts.ErrorResponse != nil {
+		opts.ErrorResponse(w, r, validationErr)
+		return false
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+
+	_ = json.NewEncoder(w).Encode(validationErr)
+
+	return false
+}
@@ -0,0 +1,290 @@
+package reqparse_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type listItemsRequest struct {
+	Page int      `query:"page" default:"1"`
+	Tags []string `query:"tag"`
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		var called bool
+
+		handler := reqparse.Middleware[listItemsRequest](nil)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+
+				req, ok := reqparse.FromContext[listItemsRequest](r.Context())
+				require.True(t, ok)
+				assert.Equal(t, listItemsRequest{Page: 2, Tags: []string{"a"}}, req)
+			}),
+		)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items?page=2&tag=a", nil))
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+
+		handler := reqparse.Middleware[listItemsRequest](nil)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Error("handler must not be called")
+			}),
+		)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items?page=x", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(
+			t,
+			`{"field_errors": {"page": ["must be a valid integer"]}, "struct_errors": []}`,
+			w.Body.String(),
+		)
+	})
+
+	t.Run("invalid struct", func(t *testing.T) {
+		t.Parallel()
+
+		type invalidRequest struct {
+			Page int
+		}
+
+		handler := reqparse.Middleware[invalidRequest](nil)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Error("handler must not be called")
+			}),
+		)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		opts := &reqparse.ParseQueryOptions{ErrorOnNoBindableFields: true}
+
+		type emptyRequest struct{}
+
+		handler := reqparse.Middleware[emptyRequest](opts)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Error("handler must not be called")
+			}),
+		)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestFromContext(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	req, ok := reqparse.FromContext[listItemsRequest](r.Context())
+	assert.False(t, ok)
+	assert.Equal(t, listItemsRequest{}, req)
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := reqparse.Handler(
+		func(w http.ResponseWriter, r *http.Request, params listItemsRequest) {
+			assert.Equal(t, listItemsRequest{Page: 1, Tags: []string{"a", "b"}}, params)
+			w.WriteHeader(http.StatusNoContent)
+		},
+		nil,
+	)
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items?tag=a&tag=b", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items?page=x&tag=a", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(
+			t,
+			`{"field_errors": {"page": ["must be a valid integer"]}, "struct_errors": []}`,
+			w.Body.String(),
+		)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		type pageRequest struct {
+			Page int `query:"page"`
+		}
+
+		var postProcessed bool
+
+		opts := &reqparse.ParseQueryOptions{
+			PostProcess: func(target any) error {
+				postProcessed = true
+				return nil
+			},
+		}
+
+		w := httptest.NewRecorder()
+		reqparse.Handler(func(w http.ResponseWriter, r *http.Request, params pageRequest) {
+			assert.Equal(t, pageRequest{Page: 3}, params)
+		}, opts).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/items?page=3", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.True(t, postProcessed)
+	})
+}
+
+func TestBindQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/items?page=2&tag=a", nil)
+
+		var params listItemsRequest
+		require.True(t, reqparse.BindQuery(w, r, &params, nil))
+		assert.Equal(t, listItemsRequest{Page: 2, Tags: []string{"a"}}, params)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("nested struct and indexed slice", func(t *testing.T) {
+		t.Parallel()
+
+		type Filter struct {
+			Color string `query:"color"`
+		}
+
+		type searchRequest struct {
+			Filter Filter `query:"filter"`
+			IDs    []int  `query:"ids"`
+		}
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(
+			http.MethodGet, "/items?filter.color=red&ids%5B0%5D=1&ids%5B1%5D=2", nil,
+		)
+
+		var params searchRequest
+		require.True(t, reqparse.BindQuery(w, r, &params, nil))
+		assert.Equal(t, searchRequest{Filter: Filter{Color: "red"}, IDs: []int{1, 2}}, params)
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		t.Parallel()
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/items?page=x", nil)
+
+		var params listItemsRequest
+		require.False(t, reqparse.BindQuery(w, r, &params, nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.JSONEq(
+			t,
+			`{"field_errors": {"page": ["must be a valid integer"]}, "struct_errors": []}`,
+			w.Body.String(),
+		)
+	})
+
+	t.Run("error response option", func(t *testing.T) {
+		t.Parallel()
+
+		opts := &reqparse.ParseQueryOptions{
+			ErrorResponse: func(
+				w http.ResponseWriter,
+				r *http.Request,
+				err *reqparse.QueryValidationError,
+			) {
+				w.Header().Set("Content-Type", reqparse.ProblemContentType)
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_ = json.NewEncoder(w).Encode(
+					err.ToProblemDetails(http.StatusUnprocessableEntity, "", ""),
+				)
+			},
+		}
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/items?page=x", nil)
+
+		var params listItemsRequest
+		require.False(t, reqparse.BindQuery(w, r, &params, opts))
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{
+			"type": "about:blank",
+			"title": "Unprocessable Entity",
+			"status": 422,
+			"detail": "Parsing query parameters failed.",
+			"errors": [
+				{
+					"field": "page",
+					"code": "invalid_integer",
+					"message": "must be a valid integer",
+					"source": "query"
+				}
+			]
+		}`, w.Body.String())
+
+		handlerW := httptest.NewRecorder()
+		reqparse.Handler(func(w http.ResponseWriter, r *http.Request, params listItemsRequest) {
+			t.Error("handler must not be called")
+		}, opts).ServeHTTP(handlerW, r)
+		assert.Equal(t, http.StatusUnprocessableEntity, handlerW.Code)
+	})
+
+	t.Run("invalid struct", func(t *testing.T) {
+		t.Parallel()
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/items", nil)
+
+		var params struct {
+			Page int
+		}
+		require.False(t, reqparse.BindQuery(w, r, &params, nil))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
@@ -0,0 +1,150 @@
+package reqparse
+
+import (
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+)
+
+// defaultMultipartMaxMemory is the default value of [ParseQueryOptions.MultipartMaxMemory]. It is
+// the same as the default of [http.Request.FormFile].
+const defaultMultipartMaxMemory = 32 << 20 // 32 MB
+
+var (
+	fileHeaderType      = reflect.TypeOf(&multipart.FileHeader{})   //nolint:gochecknoglobals
+	fileHeaderSliceType = reflect.TypeOf([]*multipart.FileHeader{}) //nolint:gochecknoglobals
+)
+
+// ParseMultipart parses the multipart form body of the request into given struct. Form field
+// names are specified by the `form` tag of the fields.
+//
+// Fields with *multipart.FileHeader type are bound to the first uploaded file of the form field
+// and []*multipart.FileHeader fields to all of the uploaded files of the form field. File fields
+// are optional unless they have `required:"true"` tag. Other fields work the same way as
+// [ParseQuery].
+//
+// If the request body is not a valid multipart form, a [QueryValidationError] with a struct error
+// is returned. Errors reading the body and [multipart.ErrMessageTooLarge] are returned as is. See
+// [ParseQueryOptions.MultipartMaxMemory] for configuring the memory limit. If options are nil,
+// default options are used.
+func ParseMultipart(r *http.Request, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseMultipart(r, target)
+}
+
+// parseMultipart is the implementation of [Parser.ParseMultipart]. opts must be non-nil.
+func parseMultipart(r *http.Request, target any, opts *ParseQueryOptions) error {
+	maxMemory := opts.MultipartMaxMemory
+	if maxMemory <= 0 {
+		maxMemory = defaultMultipartMaxMemory
+	}
+
+	if err := parseMultipartForm(r, maxMemory); err != nil {
+		if !errors.Is(err, errMalformedMultipartForm) {
+			return err
+		}
+
+		validationErrors := newQueryValidationError(SourceBody, "multipart form", opts)
+		validationErrors.addStructError("request body must be a valid multipart form")
+
+		return validationErrors.err()
+	}
+
+	source := bindingSource{
+		source:         SourceBody,
+		tagName:        "form",
+		errTagNotFound: ErrFormTagNotFound,
+		description:    "multipart form",
+		allowFiles:     true,
+		files:          r.MultipartForm.File,
+	}
+
+	return parseValues(r.MultipartForm.Value, target, source, opts)
+}
+
+// errMalformedMultipartForm is returned by [parseMultipartForm] if the request body is not a valid
+// multipart form.
+var errMalformedMultipartForm = errors.New("malformed multipart form")
+
+// parseMultipartForm calls [http.Request.ParseMultipartForm]. Errors of malformed forms, e.g. a
+// missing boundary or a truncated part, are replaced with [errMalformedMultipartForm], while the
+// errors reading the body and [multipart.ErrMessageTooLarge] are returned as is.
+func parseMultipartForm(r *http.Request, maxMemory int64) error {
+	body := r.Body
+	if body == nil {
+		body = http.NoBody
+	}
+
+	recordingBody := &errorRecordingBody{ReadCloser: body}
+	originalBody := r.Body
+	r.Body = recordingBody
+
+	err := r.ParseMultipartForm(maxMemory)
+
+	r.Body = originalBody
+
+	switch {
+	case err == nil:
+		return nil
+	case recordingBody.err != nil:
+		return recordingBody.err
+	case errors.Is(err, multipart.ErrMessageTooLarge):
+		return err
+	default:
+		return errMalformedMultipartForm
+	}
+}
+
+// errorRecordingBody records the last error of reading the body other than [io.EOF], so it can be
+// told apart from the errors of parsing the body. It also counts the bytes read from the body.
+type errorRecordingBody struct {
+	io.ReadCloser
+
+	err       error
+	bytesRead int64
+}
+
+// Read reads from the body and records the error.
+func (b *errorRecordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.bytesRead += int64(n)
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		b.err = err
+	}
+
+	return n, err
+}
+
+// isFileFieldType reports whether the field is bound from uploaded files.
+func isFileFieldType(fieldType reflect.Type) bool {
+	return fieldType == fileHeaderType || fieldType == fileHeaderSliceType
+}
+
+// populateFileField sets the uploaded files of the form field into the file field.
+func populateFileField(
+	fieldv reflect.Value,
+	structField reflect.StructField,
+	fieldKey string,
+	files map[string][]*multipart.FileHeader,
+	validationErrors *QueryValidationError,
+) {
+	fieldFiles := files[fieldKey]
+	if len(fieldFiles) == 0 && isRequiredField(structField) {
+		validationErrors.addFieldErr(fieldKey, noIndex, errRequired)
+		return
+	}
+
+	if fieldv.Type() == fileHeaderSliceType {
+		fieldv.Set(reflect.ValueOf(append([]*multipart.FileHeader{}, fieldFiles...)))
+		return
+	}
+
+	if len(fieldFiles) == 0 {
+		fieldv.Set(reflect.Zero(fieldv.Type()))
+		return
+	}
+
+	fieldv.Set(reflect.ValueOf(fieldFiles[0]))
+}
@@ -0,0 +1,253 @@
+package reqparse_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type multipartFile struct {
+	fieldName string
+	fileName  string
+	content   string
+}
+
+func newMultipartRequest(
+	t *testing.T,
+	fields map[string][]string,
+	files []multipartFile,
+) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	for name, values := range fields {
+		for _, value := range values {
+			require.NoError(t, writer.WriteField(name, value))
+		}
+	}
+
+	for _, file := range files {
+		part, err := writer.CreateFormFile(file.fieldName, file.fileName)
+		require.NoError(t, err)
+
+		_, err = part.Write([]byte(file.content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/upload?title=from-query", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return r
+}
+
+func TestParseMultipart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		r := newMultipartRequest(
+			t,
+			map[string][]string{
+				"title": {"Holiday"},
+				"tags":  {"sea", "sun"},
+			},
+			[]multipartFile{
+				{fieldName: "cover", fileName: "cover.png", content: "cover"},
+				{fieldName: "photos", fileName: "1.jpg", content: "first"},
+				{fieldName: "photos", fileName: "2.jpg", content: "second"},
+			},
+		)
+
+		type MyStruct struct {
+			Title       string                  `form:"title"`
+			Tags        []string                `form:"tags"`
+			Public      bool                    `form:"public"      default:"false"`
+			Cover       *multipart.FileHeader   `form:"cover"`
+			Photos      []*multipart.FileHeader `form:"photos"`
+			Thumbnail   *multipart.FileHeader   `form:"thumbnail"`
+			Attachments []*multipart.FileHeader `form:"attachments"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseMultipart(r, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Holiday", s.Title)
+		assert.Equal(t, []string{"sea", "sun"}, s.Tags)
+		assert.False(t, s.Public)
+		require.NotNil(t, s.Cover)
+		assert.Equal(t, "cover.png", s.Cover.Filename)
+		require.Len(t, s.Photos, 2)
+		assert.Equal(t, "1.jpg", s.Photos[0].Filename)
+		assert.Equal(t, "2.jpg", s.Photos[1].Filename)
+		assert.Nil(t, s.Thumbnail)
+		assert.Equal(t, []*multipart.FileHeader{}, s.Attachments)
+
+		f, err := s.Photos[1].Open()
+		require.NoError(t, err)
+
+		defer f.Close()
+
+		var content bytes.Buffer
+		_, err = content.ReadFrom(f)
+		require.NoError(t, err)
+		assert.Equal(t, "second", content.String())
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		r := newMultipartRequest(
+			t,
+			map[string][]string{"count": {"many"}},
+			nil,
+		)
+
+		type MyStruct struct {
+			Title  string                  `form:"title"`
+			Count  int                     `form:"count"`
+			Cover  *multipart.FileHeader   `form:"cover"  required:"true"`
+			Photos []*multipart.FileHeader `form:"photos" required:"true"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseMultipart(r, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"title":  {"field is required"},
+			"count":  {"must be a valid integer"},
+			"cover":  {"field is required"},
+			"photos": {"field is required"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("not a multipart form", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("title=a"))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		type MyStruct struct {
+			Title string `form:"title"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseMultipart(r, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(
+			t,
+			[]string{"request body must be a valid multipart form"},
+			validationError.StructErrors,
+		)
+		assert.Empty(t, validationError.FieldErrors)
+	})
+
+	t.Run("errors reading the body", func(t *testing.T) {
+		t.Parallel()
+
+		errRead := errors.New("connection reset")
+		r := newMultipartRequest(t, map[string][]string{"title": {"a"}}, nil)
+		r.Body = io.NopCloser(io.MultiReader(
+			io.LimitReader(r.Body, 10), iotest.ErrReader(errRead),
+		))
+
+		type MyStruct struct {
+			Title string `form:"title"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseMultipart(r, &s, nil)
+
+		require.ErrorIs(t, err, errRead)
+	})
+
+	t.Run("form values too large", func(t *testing.T) {
+		t.Parallel()
+
+		// Form values may exceed the memory limit by 10 MB.
+		r := newMultipartRequest(
+			t, map[string][]string{"title": {strings.Repeat("a", 10<<20+2)}}, nil,
+		)
+
+		type MyStruct struct {
+			Title string `form:"title"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseMultipart(r, &s, &reqparse.ParseQueryOptions{MultipartMaxMemory: 1})
+
+		require.ErrorIs(t, err, multipart.ErrMessageTooLarge)
+	})
+
+	t.Run("form tag not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := newMultipartRequest(t, map[string][]string{}, nil)
+
+		type MyStruct struct {
+			Cover *multipart.FileHeader `query:"cover"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseMultipart(r, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrFormTagNotFound)
+	})
+
+	t.Run("file fields are not allowed for query parsing", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Cover *multipart.FileHeader `query:"cover"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+	})
+
+	t.Run("memory limit", func(t *testing.T) {
+		t.Parallel()
+
+		r := newMultipartRequest(
+			t,
+			nil,
+			[]multipartFile{
+				{fieldName: "cover", fileName: "cover.png", content: strings.Repeat("a", 1024)},
+			},
+		)
+
+		type MyStruct struct {
+			Cover *multipart.FileHeader `form:"cover"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseMultipart(r, &s, &reqparse.ParseQueryOptions{MultipartMaxMemory: 10})
+
+		require.NoError(t, err)
+		require.NotNil(t, s.Cover)
+		assert.Equal(t, int64(1024), s.Cover.Size)
+		require.NoError(t, r.MultipartForm.RemoveAll())
+	})
+}
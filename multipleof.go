@@ -0,0 +1,53 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// multipleOfTolerance is the tolerance of [MultipleOf] for the rounding errors of float values.
+const multipleOfTolerance = 1e-9
+
+// multipleOfConstraint creates the [MultipleOf] rule of the `multipleof` tag or the "multipleof"
+// constraint of the `validate` tag. ok is false if the argument isn't a positive number or the
+// values aren't numeric.
+func multipleOfConstraint(arg string, valueType reflect.Type) (rule Rule, ok bool) {
+	switch valueType.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return nil, false
+	}
+
+	n, err := strconv.ParseFloat(arg, 64)
+	if err != nil || !(n > 0) {
+		return nil, false
+	}
+
+	return MultipleOf(n), true
+}
+
+// multipleOfRule returns the rule of the `multipleof` tag of the field, e.g. `multipleof:"10"`. ok
+// is false if there is no such tag. The tag is allowed only on numeric fields and slices and
+// pointers of them, and its value must be a positive number; otherwise [ErrInvalidTag] error is
+// returned.
+func multipleOfRule(
+	structField reflect.StructField,
+	converters []Converter,
+) (rule Rule, ok bool, err error) {
+	arg, ok := structField.Tag.Lookup("multipleof")
+	if !ok {
+		return nil, false, nil
+	}
+
+	rule, ok = multipleOfConstraint(arg, fieldValueType(structField.Type, converters))
+	if !ok {
+		return nil, false, fmt.Errorf(
+			"%w: multipleof:%q (%s)", ErrInvalidTag, arg, structField.Name,
+		)
+	}
+
+	return rule, true, nil
+}
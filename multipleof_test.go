@@ -0,0 +1,85 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryMultipleOf(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		PageSize int       `default:"20"       multipleof:"10"        query:"page_size"`
+		Amounts  []float64 `multipleof:"0.05"  query:"amount"`
+		Offset   *uint     `query:"offset"     validate:"multipleof=5"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"page_size": {"50"},
+			"amount":    {"1.15", "0.1"},
+			"offset":    {"25"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		offset := uint(25)
+		assert.Equal(t, MyStruct{
+			PageSize: 50,
+			Amounts:  []float64{1.15, 0.1},
+			Offset:   &offset,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"page_size": {"25"},
+			"amount":    {"1.15", "0.12"},
+			"offset":    {"3"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page_size": {"must be a multiple of 10"},
+			"amount":    {"(Index: 1) must be a multiple of 0.05"},
+			"offset":    {"must be a multiple of 5"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid multipleof tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				Name string `multipleof:"5" query:"name"`
+			}{},
+			&struct {
+				N int `multipleof:"0" query:"n"`
+			}{},
+			&struct {
+				N int `multipleof:"ten" query:"n"`
+			}{},
+			&struct {
+				N int `query:"n" validate:"multipleof=-5"`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
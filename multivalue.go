@@ -0,0 +1,100 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MultiValuePolicy is the behavior used when a field casted from a single value, e.g. an int
+// field, has multiple values in the source, e.g. `?page=1&page=2`.
+type MultiValuePolicy string
+
+const (
+	// MultiValueFirst uses the first value and ignores the others. It is the default policy.
+	MultiValueFirst MultiValuePolicy = "first"
+
+	// MultiValueLast uses the last value and ignores the others.
+	MultiValueLast MultiValuePolicy = "last"
+
+	// MultiValueError reports a validation error for the field.
+	MultiValueError MultiValuePolicy = "error"
+)
+
+// errMultipleValues is the validation error of the fields with multiple values when the policy is
+// [MultiValueError].
+var errMultipleValues = newCodedError( //nolint:gochecknoglobals
+	CodeMultipleValues, "parameter provided multiple times", nil,
+)
+
+// isValidMultiValuePolicy reports whether the policy is one of the defined policies or empty.
+func isValidMultiValuePolicy(policy MultiValuePolicy) bool {
+	switch policy {
+	case "", MultiValueFirst, MultiValueLast, MultiValueError:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkMultiValuePolicy returns an error if the MultiValuePolicy option is invalid.
+func checkMultiValuePolicy(opts *ParseQueryOptions) error {
+	if !isValidMultiValuePolicy(opts.MultiValuePolicy) {
+		return fmt.Errorf("%w: MultiValuePolicy %q", ErrInvalidOption, opts.MultiValuePolicy)
+	}
+
+	return nil
+}
+
+// multiValuePolicyFromTag returns the policy in the `multivalue` tag of the field. It is empty if
+// there is no such tag. isSingleValue reports whether the field is casted from a single value,
+// which are the only fields the tag is allowed on.
+func multiValuePolicyFromTag(
+	structField reflect.StructField,
+	isSingleValue bool,
+) (MultiValuePolicy, error) {
+	tag, ok := structField.Tag.Lookup("multivalue")
+	if !ok {
+		return "", nil
+	}
+
+	policy := MultiValuePolicy(tag)
+	if policy == "" || !isValidMultiValuePolicy(policy) || !isSingleValue {
+		return "", fmt.Errorf("%w: multivalue:%q (%s)", ErrInvalidTag, tag, structField.Name)
+	}
+
+	return policy, nil
+}
+
+// resolvedMultiValuePolicy returns the multi-value policy of the field: the policy selected by its
+// `multivalue` tag, or the policy of the options.
+func (p *fieldPlan) resolvedMultiValuePolicy(opts *ParseQueryOptions) MultiValuePolicy {
+	if p.multiValuePolicy != "" {
+		return p.multiValuePolicy
+	}
+
+	return opts.MultiValuePolicy
+}
+
+// applyMultiValuePolicy returns the value used for the field casted from a single value, which has
+// the values in the source, according to the multi-value policy of the field. It reports a
+// validation error and returns false if the policy is [MultiValueError] and there are multiple
+// values.
+func (p *fieldPlan) applyMultiValuePolicy(
+	values []string,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) ([]string, bool) {
+	if len(values) <= 1 {
+		return values, true
+	}
+
+	switch p.resolvedMultiValuePolicy(opts) { //nolint:exhaustive
+	case MultiValueLast:
+		return values[len(values)-1:], true
+	case MultiValueError:
+		validationErrors.addFieldErr(p.key, noIndex, errMultipleValues)
+		return nil, false
+	default:
+		return values[:1], true
+	}
+}
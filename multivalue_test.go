@@ -0,0 +1,132 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryMultiValuePolicy(t *testing.T) {
+	t.Parallel()
+
+	type Filter struct {
+		Status string `json:"status"`
+	}
+
+	type MyStruct struct {
+		Page   int      `query:"page"`
+		Sort   *string  `query:"sort"`
+		Limit  int      `query:"limit"  multivalue:"last"`
+		Token  string   `query:"token"  multivalue:"error"`
+		Cursor string   `query:"cursor" multivalue:"first"`
+		Filter *Filter  `query:"filter" encoding:"json"`
+		Tags   []string `query:"tag"`
+	}
+
+	inputQueryParams := map[string][]string{
+		"page":   {"1", "2"},
+		"sort":   {"name", "price"},
+		"limit":  {"10", "20"},
+		"token":  {"abc"},
+		"cursor": {"a", "b"},
+		"filter": {`{"status":"active"}`, `{"status":"draft"}`},
+		"tag":    {"a", "b"},
+	}
+
+	t.Run("default policy", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		sort := "name"
+		assert.Equal(t, MyStruct{
+			Page:   1,
+			Sort:   &sort,
+			Limit:  20,
+			Token:  "abc",
+			Cursor: "a",
+			Filter: &Filter{Status: "active"},
+			Tags:   []string{"a", "b"},
+		}, s)
+	})
+
+	t.Run("last policy", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{MultiValuePolicy: reqparse.MultiValueLast},
+		)
+		require.NoError(t, err)
+
+		sort := "price"
+		assert.Equal(t, MyStruct{
+			Page:   2,
+			Sort:   &sort,
+			Limit:  20,
+			Token:  "abc",
+			Cursor: "a",
+			Filter: &Filter{Status: "draft"},
+			Tags:   []string{"a", "b"},
+		}, s)
+	})
+
+	t.Run("error policy", func(t *testing.T) {
+		t.Parallel()
+
+		params := map[string][]string{"token": {"abc", "def"}}
+		for key, values := range inputQueryParams {
+			if key != "token" {
+				params[key] = values
+			}
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			params,
+			&s,
+			&reqparse.ParseQueryOptions{MultiValuePolicy: reqparse.MultiValueError},
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page":   {"parameter provided multiple times"},
+			"sort":   {"parameter provided multiple times"},
+			"token":  {"parameter provided multiple times"},
+			"filter": {"parameter provided multiple times"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{MultiValuePolicy: "all"},
+		)
+		require.ErrorIs(t, err, reqparse.ErrInvalidOption)
+
+		type UnknownPolicy struct {
+			Page int `query:"page" multivalue:"all"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &UnknownPolicy{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type SliceField struct {
+			Tags []string `query:"tag" multivalue:"last"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &SliceField{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
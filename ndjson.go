@@ -0,0 +1,72 @@
+package reqparse
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// LineError is the error of a single line of a newline delimited JSON body. Err is typically a
+// [QueryValidationError], which can be retrieved with [errors.As].
+type LineError struct {
+	// Line is the 1-based line number of the row in the body.
+	Line int
+
+	// Err is the error of the line.
+	Err error
+}
+
+func (e *LineError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ParseNDJSON parses the newline delimited JSON body read from r row by row, without buffering
+// the whole body. Each non-blank line is decoded into a new T the same way as [ParseJSON], and
+// yield is called with the row and a nil error, or with the row and a [LineError] wrapping the
+// validation error of the row. Parsing continues with the next line after validation errors.
+//
+// Errors reading from r and other errors, e.g. [ErrInvalidQueryTarget] if T is not a struct, are
+// passed to yield as is and stop the parsing. Parsing also stops when yield returns false.
+// If options are nil, default options are used.
+//
+// See ParseNDJSONStream for the iterator version on Go 1.23 and later.
+func ParseNDJSON[T any](r io.Reader, opts *ParseQueryOptions, yield func(T, error) bool) {
+	parser := NewParser(opts)
+	reader := bufio.NewReader(r)
+
+	for line := 1; ; line++ {
+		content, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			var zero T
+
+			yield(zero, readErr)
+
+			return
+		}
+
+		if len(bytes.TrimSpace(content)) > 0 {
+			var row T
+
+			err := parser.ParseJSON(bytes.NewReader(content), &row)
+
+			var validationError *QueryValidationError
+			if errors.As(err, &validationError) {
+				err = &LineError{Line: line, Err: err}
+			}
+
+			if !yield(row, err) || (err != nil && validationError == nil) {
+				return
+			}
+		}
+
+		if readErr != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,18 @@
+//go:build go1.23
+
+package reqparse
+
+import (
+	"io"
+	"iter"
+)
+
+// ParseNDJSONStream returns an iterator over the rows of the newline delimited JSON body read from
+// r. The rows and their errors are produced the same way as [ParseNDJSON].
+//
+// ParseNDJSONStream requires Go 1.23 or later.
+func ParseNDJSONStream[T any](r io.Reader, opts *ParseQueryOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ParseNDJSON(r, opts, yield)
+	}
+}
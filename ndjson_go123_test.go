@@ -0,0 +1,34 @@
+//go:build go1.23
+
+package reqparse_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNDJSONStream(t *testing.T) {
+	t.Parallel()
+
+	body := "{\"name\": \"Berk\", \"age\": 25}\n{\"age\": 30}\n"
+
+	var rows []ndjsonRow
+
+	for row, err := range reqparse.ParseNDJSONStream[ndjsonRow](strings.NewReader(body), nil) {
+		if err != nil {
+			var lineError *reqparse.LineError
+			require.ErrorAs(t, err, &lineError)
+			assert.Equal(t, 2, lineError.Line)
+
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	assert.Equal(t, []ndjsonRow{{Name: "Berk", Age: 25}}, rows)
+}
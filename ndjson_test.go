@@ -0,0 +1,112 @@
+package reqparse_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ndjsonRow struct {
+	Name string `json:"name" required:"true"`
+	Age  int    `json:"age"  default:"0"`
+}
+
+func TestParseNDJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rows with validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		body := `{"name": "Berk", "age": 25}
+
+{"age": "old"}
+not json
+{"name": "Ada"}`
+
+		var (
+			rows []ndjsonRow
+			errs []error
+		)
+
+		reqparse.ParseNDJSON(strings.NewReader(body), nil, func(row ndjsonRow, err error) bool {
+			rows = append(rows, row)
+			errs = append(errs, err)
+
+			return true
+		})
+
+		require.Len(t, rows, 4)
+		assert.Equal(t, ndjsonRow{Name: "Berk", Age: 25}, rows[0])
+		assert.Equal(t, ndjsonRow{Name: "Ada"}, rows[3])
+		require.NoError(t, errs[0])
+		require.NoError(t, errs[3])
+
+		var lineError *reqparse.LineError
+		require.ErrorAs(t, errs[1], &lineError)
+		assert.Equal(t, 3, lineError.Line)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, errs[1], &validationError)
+		assert.Equal(t, map[string][]string{
+			"name": {"field is required"},
+			"age":  {"must be a valid integer"},
+		}, validationError.FieldErrors)
+
+		require.ErrorAs(t, errs[2], &lineError)
+		assert.Equal(t, 4, lineError.Line)
+		require.ErrorAs(t, errs[2], &validationError)
+		assert.Equal(t, []string{"request body must be valid JSON"}, validationError.StructErrors)
+		assert.True(t, strings.HasPrefix(errs[2].Error(), "line 4: Parsing JSON body failed."))
+	})
+
+	t.Run("stops when yield returns false", func(t *testing.T) {
+		t.Parallel()
+
+		body := "{\"name\": \"a\"}\n{\"name\": \"b\"}\n{\"name\": \"c\"}\n"
+
+		var names []string
+
+		reqparse.ParseNDJSON(strings.NewReader(body), nil, func(row ndjsonRow, err error) bool {
+			names = append(names, row.Name)
+			return len(names) < 2
+		})
+
+		assert.Equal(t, []string{"a", "b"}, names)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+
+		r := io.MultiReader(strings.NewReader("{\"name\": \"a\"}\n{\"na"), errReader{})
+
+		var errs []error
+
+		reqparse.ParseNDJSON(r, nil, func(_ ndjsonRow, err error) bool {
+			errs = append(errs, err)
+			return true
+		})
+
+		require.Len(t, errs, 2)
+		require.NoError(t, errs[0])
+		require.EqualError(t, errs[1], "read failed")
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		t.Parallel()
+
+		var errs []error
+
+		reqparse.ParseNDJSON(strings.NewReader("1\n2\n"), nil, func(_ int, err error) bool {
+			errs = append(errs, err)
+			return true
+		})
+
+		require.Len(t, errs, 1)
+		assert.True(t, errors.Is(errs[0], reqparse.ErrInvalidQueryTarget))
+	})
+}
@@ -0,0 +1,335 @@
+package reqparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryNestedStructs(t *testing.T) {
+	t.Parallel()
+
+	type PriceRange struct {
+		Min int  `query:"min_price"`
+		Max *int `query:"max_price"`
+	}
+
+	type Filter struct {
+		Price  PriceRange `query:"price"`
+		Colors []string   `query:"color"`
+		Since  time.Time  `query:"since"  default:"2024-01-01T00:00:00Z"`
+	}
+
+	type Sort struct {
+		Field string `query:"field"`
+		Desc  bool   `query:"desc" default:"false"`
+	}
+
+	type MyStruct struct {
+		Query  string `query:"q"`
+		Filter Filter `query:"filter"`
+		Sort   *Sort  `query:"sort"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"q":                      {"shoes"},
+			"filter.price.min_price": {"10"},
+			"filter.price.max_price": {"20"},
+			"filter.color":           {"red", "blue"},
+			"sort.field":             {"price"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		maxPrice := 20
+		assert.Equal(t, MyStruct{
+			Query: "shoes",
+			Filter: Filter{
+				Price:  PriceRange{Min: 10, Max: &maxPrice},
+				Colors: []string{"red", "blue"},
+				Since:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Sort: &Sort{Field: "price"},
+		}, s)
+	})
+
+	t.Run("absent pointer struct", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"q":                      {"shoes"},
+			"filter.price.min_price": {"10"},
+		}
+
+		s := MyStruct{Sort: &Sort{Field: "stale"}}
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, s.Sort)
+		assert.Nil(t, s.Filter.Price.Max)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"filter.price.max_price": {"x"},
+			"filter.since":           {"yesterday"},
+			"sort.desc":              {"maybe"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"q":                      {"field is required"},
+			"filter.price.min_price": {"field is required"},
+			"filter.price.max_price": {"must be a valid integer"},
+			"filter.since":           {"must be a valid date"},
+			"sort.field":             {"field is required"},
+			"sort.desc":              {"must be a valid boolean"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("required pointer struct", func(t *testing.T) {
+		t.Parallel()
+
+		type RequiredStruct struct {
+			Sort *Sort `query:"sort" required:"true"`
+		}
+
+		var s RequiredStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"sort": {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("nested struct without tag", func(t *testing.T) {
+		t.Parallel()
+
+		type Inner struct {
+			Value string
+			Other string `query:"other"`
+		}
+
+		type InvalidStruct struct {
+			Inner Inner `query:"inner"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.ErrorIs(t, err, reqparse.ErrQueryTagNotFound)
+	})
+
+	t.Run("recursive struct", func(t *testing.T) {
+		t.Parallel()
+
+		type Node struct {
+			Name string `query:"name"`
+			Next *Node  `query:"next"`
+		}
+
+		var s Node
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+	})
+}
+
+type PaginationParams struct {
+	Page    int `query:"page"     default:"1"`
+	PerPage int `query:"per_page" default:"20"`
+}
+
+type sortParams struct {
+	SortBy string `query:"sort_by" default:"id"`
+}
+
+func TestParseQueryEmbeddedStructs(t *testing.T) {
+	t.Parallel()
+
+	type Filter struct {
+		PaginationParams
+		Status string `query:"status"`
+	}
+
+	type MyStruct struct {
+		PaginationParams
+		sortParams
+		Query  string  `query:"q"`
+		Filter *Filter `query:"filter"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"q":             {"shoes"},
+			"page":          {"3"},
+			"sort_by":       {"price"},
+			"filter.status": {"active"},
+			"filter.page":   {"2"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, s.Page)
+		assert.Equal(t, 20, s.PerPage)
+		assert.Equal(t, "price", s.SortBy)
+		assert.Equal(t, "shoes", s.Query)
+		require.NotNil(t, s.Filter)
+		assert.Equal(t, Filter{
+			PaginationParams: PaginationParams{Page: 2, PerPage: 20},
+			Status:           "active",
+		}, *s.Filter)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"q":               {"shoes"},
+			"per_page":        {"many"},
+			"filter.per_page": {"x"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"per_page":        {"must be a valid integer"},
+			"filter.per_page": {"must be a valid integer"},
+			"filter.status":   {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("embedded pointer", func(t *testing.T) {
+		t.Parallel()
+
+		type PointerStruct struct {
+			*PaginationParams
+			Query string `query:"q"`
+		}
+
+		var s PointerStruct
+		err := reqparse.ParseQuery(map[string][]string{"q": {"shoes"}}, &s, nil)
+		require.NoError(t, err)
+
+		require.NotNil(t, s.PaginationParams)
+		assert.Equal(t, PaginationParams{Page: 1, PerPage: 20}, *s.PaginationParams)
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"q": {"shoes"}, "sort_by": {"name"}},
+			&s,
+			&reqparse.ParseQueryOptions{Atomic: true},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, s.Page)
+		assert.Equal(t, "name", s.SortBy)
+	})
+}
+
+func TestParseQueryDeepObjectStructs(t *testing.T) {
+	t.Parallel()
+
+	type PriceRange struct {
+		Min int `query:"min"`
+		Max int `query:"max" default:"100"`
+	}
+
+	type Filter struct {
+		Status string            `query:"status"`
+		Price  *PriceRange       `query:"price"`
+		Meta   map[string]string `query:"meta"`
+	}
+
+	type MyStruct struct {
+		Filter Filter      `query:"filter" style:"deepObject"`
+		Sort   *PriceRange `query:"sort"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"filter[status]":       {"active"},
+			"filter[price][min]":   {"3"},
+			"filter[meta][color]":  {"red"},
+			"filter.status":        {"ignored"},
+			"sort.min":             {"1"},
+			"sort[max]":            {"ignored"},
+			"filter[price][other]": {"x"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams,
+			&s,
+			&reqparse.ParseQueryOptions{MapKeyStyle: reqparse.MapKeyStyleDot},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Filter: Filter{
+				Status: "active",
+				Price:  &PriceRange{Min: 3, Max: 100},
+				Meta:   map[string]string{"color": "red"},
+			},
+			Sort: &PriceRange{Min: 1, Max: 100},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"filter[price][max]": {"x"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"filter[status]":     {"field is required"},
+			"filter[price][min]": {"field is required"},
+			"filter[price][max]": {"must be a valid integer"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid style tag", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			Filter Filter `query:"filter" style:"form"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
@@ -0,0 +1,52 @@
+package reqparse
+
+import (
+	"net"
+	"net/netip"
+	"reflect"
+)
+
+//nolint:gochecknoglobals
+var (
+	errInvalidIPAddress = newCodedError(CodeInvalidIPAddress, "must be a valid IP address", nil)
+	errInvalidIPPrefix  = newCodedError(CodeInvalidIPPrefix, "must be a valid IP prefix", nil)
+)
+
+var (
+	netipAddrType   = reflect.TypeOf(netip.Addr{})   //nolint:gochecknoglobals
+	netipPrefixType = reflect.TypeOf(netip.Prefix{}) //nolint:gochecknoglobals
+	netIPType       = reflect.TypeOf(net.IP{})       //nolint:gochecknoglobals
+)
+
+// castNetworkValue casts the value into a netip.Addr, netip.Prefix or net.IP value. ok is false if
+// the target type is none of them. Unlike their UnmarshalText methods, empty values are invalid.
+func castNetworkValue(targetType reflect.Type, value string) (reflect.Value, bool, error) {
+	switch targetType {
+	case netipAddrType:
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return reflect.Value{}, true, errInvalidIPAddress
+		}
+
+		return reflect.ValueOf(addr), true, nil
+
+	case netipPrefixType:
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return reflect.Value{}, true, errInvalidIPPrefix
+		}
+
+		return reflect.ValueOf(prefix), true, nil
+
+	case netIPType:
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return reflect.Value{}, true, errInvalidIPAddress
+		}
+
+		return reflect.ValueOf(ip), true, nil
+
+	default:
+		return reflect.Value{}, false, nil
+	}
+}
@@ -0,0 +1,92 @@
+package reqparse_test
+
+import (
+	"net"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryNetworkAddresses(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Addr     netip.Addr     `query:"addr"`
+		CIDR     netip.Prefix   `query:"cidr"`
+		Excluded []netip.Prefix `query:"excluded"`
+		Gateway  *netip.Prefix  `query:"gateway"`
+		IP       net.IP         `query:"ip"       default:"127.0.0.1"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"addr":     {"fe80::1%eth0"},
+			"cidr":     {"10.0.0.0/8"},
+			"excluded": {"10.1.0.0/16", "2001:db8::/32"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Addr: netip.MustParseAddr("fe80::1%eth0"),
+			CIDR: netip.MustParsePrefix("10.0.0.0/8"),
+			Excluded: []netip.Prefix{
+				netip.MustParsePrefix("10.1.0.0/16"),
+				netip.MustParsePrefix("2001:db8::/32"),
+			},
+			IP: net.ParseIP("127.0.0.1"),
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"addr":     {""},
+			"cidr":     {"10.0.0.0"},
+			"excluded": {"10.1.0.0/16", "10.1.0.0/33"},
+			"gateway":  {"10.0.0.1"},
+			"ip":       {"::g"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"addr":     {"must be a valid IP address"},
+			"cidr":     {"must be a valid IP prefix"},
+			"excluded": {"(Index: 1) must be a valid IP prefix"},
+			"gateway":  {"must be a valid IP prefix"},
+			"ip":       {"must be a valid IP address"},
+		}, validationErr.FieldErrors)
+	})
+}
+
+func TestParseJSONNetworkAddresses(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Addr netip.Addr   `json:"addr"`
+		CIDR netip.Prefix `json:"cidr"`
+	}
+
+	var s MyStruct
+	err := reqparse.ParseJSON(strings.NewReader(`{"addr": "10.0.0", "cidr": "10/8"}`), &s, nil)
+
+	var validationErr *reqparse.QueryValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, map[string][]string{
+		"addr": {"must be a valid IP address"},
+		"cidr": {"must be a valid IP prefix"},
+	}, validationErr.FieldErrors)
+}
@@ -0,0 +1,111 @@
+package reqparse
+
+import (
+	"io"
+	"net/http"
+)
+
+// Parser parses requests with a fixed set of options. It is useful for configuring the options
+// once and reusing them across handlers. A Parser is safe for concurrent use.
+type Parser struct {
+	opts ParseQueryOptions
+}
+
+// NewParser creates a new [Parser] with the given options. If options are nil, default options are
+// used. The options are copied, so modifying them after calling NewParser has no effect on the
+// returned parser.
+func NewParser(opts *ParseQueryOptions) *Parser {
+	p := &Parser{}
+	if opts != nil {
+		p.opts = *opts
+	}
+
+	return p
+}
+
+// ParseQuery parses query parameters into given struct. See [ParseQuery] for details.
+func (p *Parser) ParseQuery(queryParams map[string][]string, target any) error {
+	return parseValues(queryParams, target, querySource, &p.opts)
+}
+
+// ParseQueryDynamic parses query parameters according to the given schema. See
+// [ParseQueryDynamic] for details.
+func (p *Parser) ParseQueryDynamic(
+	queryParams map[string][]string,
+	schema []FieldSpec,
+) (map[string]any, error) {
+	return parseQueryDynamic(queryParams, schema, &p.opts)
+}
+
+// ParseQueryArgs parses the query parameters of args into given struct. See [ParseQueryArgs] for
+// details.
+func (p *Parser) ParseQueryArgs(args QueryArgs, target any) error {
+	return parseQueryArgs(args, target, &p.opts)
+}
+
+// ParseQueryString parses the raw query string into given struct. See [ParseQueryString] for
+// details.
+func (p *Parser) ParseQueryString(rawQuery string, target any) error {
+	return parseQueryArgs(rawQueryArgs(rawQuery), target, &p.opts)
+}
+
+// ParseQueryRequest parses the query parameters of the request into given struct. See
+// [ParseQueryRequest] for details.
+func (p *Parser) ParseQueryRequest(r *http.Request, target any) error {
+	return parseQueryArgs(rawQueryArgs(r.URL.RawQuery), target, p.opts.requestOptions(r))
+}
+
+// ParsePath parses URL path parameters into given struct. See [ParsePath] for details.
+func (p *Parser) ParsePath(pathParams map[string]string, target any) error {
+	return parseValues(pathValues(pathParams), target, pathSource, &p.opts)
+}
+
+// ParsePathFromRequest parses the path parameters of the request into given struct. See
+// [ParsePathFromRequest] for details.
+func (p *Parser) ParsePathFromRequest(r *http.Request, target any) error {
+	return parsePathFromRequest(r, target, p.opts.requestOptions(r))
+}
+
+// ParseHeader parses request headers into given struct. See [ParseHeader] for details.
+func (p *Parser) ParseHeader(header http.Header, target any) error {
+	return parseHeader(header, target, &p.opts)
+}
+
+// ParseMultipart parses the multipart form body of the request into given struct. See
+// [ParseMultipart] for details.
+func (p *Parser) ParseMultipart(r *http.Request, target any) error {
+	return parseMultipart(r, target, p.opts.requestOptions(r))
+}
+
+// ParseJSON decodes the JSON object read from r into given struct. See [ParseJSON] for details.
+func (p *Parser) ParseJSON(r io.Reader, target any) error {
+	return parseJSON(r, target, &p.opts)
+}
+
+// ParseXML decodes the XML document read from r into given struct. See [ParseXML] for details.
+func (p *Parser) ParseXML(r io.Reader, target any) error {
+	return parseXML(r, target, &p.opts)
+}
+
+// ParseYAML decodes the YAML document read from r into given struct. See [ParseYAML] for details.
+func (p *Parser) ParseYAML(r io.Reader, target any) error {
+	return parseYAML(r, target, &p.opts)
+}
+
+// ParseBody parses the request body into given struct with the parser of its Content-Type. See
+// [ParseBody] for details.
+func (p *Parser) ParseBody(r *http.Request, target any) error {
+	return parseBody(r, target, p.opts.requestOptions(r))
+}
+
+// ParseForwarded parses the forwarding information of the request. See [ParseForwarded] for
+// details.
+func (p *Parser) ParseForwarded(r *http.Request) (Forwarded, error) {
+	return parseForwarded(r, &p.opts)
+}
+
+// ParseRequest parses the query parameters, path parameters, headers, cookies and JSON body of the
+// request into given struct. See [ParseRequest] for details.
+func (p *Parser) ParseRequest(r *http.Request, target any) error {
+	return parseRequest(r, target, p.opts.requestOptions(r))
+}
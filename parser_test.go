@@ -0,0 +1,92 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParser(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Name    string `query:"name"`
+		NoCache bool   `query:"no-cache"`
+	}
+
+	t.Run("ParseQuery uses parser options", func(t *testing.T) {
+		t.Parallel()
+
+		parser := reqparse.NewParser(&reqparse.ParseQueryOptions{PresenceBools: true})
+
+		var s MyStruct
+		err := parser.ParseQuery(map[string][]string{"name": {"John"}, "no-cache": {""}}, &s)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: "John", NoCache: true}, s)
+	})
+
+	t.Run("ParseRequest", func(t *testing.T) {
+		t.Parallel()
+
+		parser := reqparse.NewParser(&reqparse.ParseQueryOptions{PresenceBools: true})
+		r := httptest.NewRequest(http.MethodGet, "/search?name=John&no-cache", nil)
+
+		var s MyStruct
+		err := parser.ParseRequest(r, &s)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: "John", NoCache: true}, s)
+	})
+
+	t.Run("ParseRequest binds all sources", func(t *testing.T) {
+		t.Parallel()
+
+		type RequestStruct struct {
+			Name      string `query:"name"`
+			RequestID string `header:"X-Request-Id"`
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/search?name=John", nil)
+		r.Header.Set("X-Request-Id", "abc")
+
+		var s RequestStruct
+		err := reqparse.NewParser(nil).ParseRequest(r, &s)
+
+		require.NoError(t, err)
+		assert.Equal(t, RequestStruct{Name: "John", RequestID: "abc"}, s)
+	})
+
+	t.Run("options are copied", func(t *testing.T) {
+		t.Parallel()
+
+		opts := &reqparse.ParseQueryOptions{PresenceBools: true}
+		parser := reqparse.NewParser(opts)
+		opts.PresenceBools = false
+
+		var s MyStruct
+		err := parser.ParseQuery(map[string][]string{"name": {"John"}}, &s)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: "John", NoCache: false}, s)
+	})
+
+	t.Run("nil options", func(t *testing.T) {
+		t.Parallel()
+
+		parser := reqparse.NewParser(nil)
+
+		var s MyStruct
+		err := parser.ParseQuery(map[string][]string{"name": {"John"}}, &s)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"no-cache": {"field is required"},
+		}, validationError.FieldErrors)
+	})
+}
@@ -0,0 +1,81 @@
+package reqparse
+
+import (
+	"net/http"
+	"reflect"
+)
+
+var pathSource = bindingSource{ //nolint:gochecknoglobals
+	source:         SourcePath,
+	tagName:        "path",
+	errTagNotFound: ErrPathTagNotFound,
+	description:    "path parameters",
+}
+
+// ParsePath parses URL path parameters into given struct. Path parameter names are specified by
+// the `path` tag of the fields. pathParams is typically obtained from the router, e.g.
+// chi.RouteContext(r.Context()) or mux.Vars(r).
+//
+// Type casting, default values, required fields and validation errors work the same way as
+// [ParseQuery]; validation errors are reported with [QueryValidationError] type.
+// If options are nil, default options are used.
+func ParsePath(pathParams map[string]string, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParsePath(pathParams, target)
+}
+
+// ParsePathFromRequest parses the path parameters of the request into given struct. The value of
+// each field with a `path` tag is obtained with [http.Request.PathValue], so the path parameters
+// of the patterns matched by [http.ServeMux] are bound without building a map. If the
+// [ParseQueryOptions.PathParams] option is set, the path parameters are obtained from it instead.
+// Empty path values are treated as absent.
+//
+// [http.Request.PathValue] is available since Go 1.22; with older versions of Go, path parameters
+// are absent unless the PathParams option is set. Binding works the same way as [ParsePath]. If
+// options are nil, default options are used.
+func ParsePathFromRequest(r *http.Request, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParsePathFromRequest(r, target)
+}
+
+// parsePathFromRequest is the implementation of [Parser.ParsePathFromRequest]. opts must be
+// non-nil.
+func parsePathFromRequest(r *http.Request, target any, opts *ParseQueryOptions) error {
+	if opts.PathParams != nil {
+		return parseValues(pathValues(opts.PathParams(r)), target, pathSource, opts)
+	}
+
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryTarget
+	}
+
+	plan, err := cachedStructPlan(v.Elem().Type(), pathSource, opts)
+	if err != nil {
+		return err
+	}
+
+	return plan.bind(requestPathValues(r, plan.fieldKeys()), v, pathSource, opts)
+}
+
+// requestPathValues returns the path values of the request for the field keys, see
+// [requestPathValue]. Field keys without a path value are absent.
+func requestPathValues(r *http.Request, fieldKeys []string) map[string][]string {
+	values := make(map[string][]string, len(fieldKeys))
+
+	for _, fieldKey := range fieldKeys {
+		if value, ok := requestPathValue(r, fieldKey); ok {
+			values[fieldKey] = []string{value}
+		}
+	}
+
+	return values
+}
+
+// pathValues converts path parameters into the value format used for binding.
+func pathValues(pathParams map[string]string) map[string][]string {
+	values := make(map[string][]string, len(pathParams))
+	for k, v := range pathParams { //nolint:wsl
+		values[k] = []string{v}
+	}
+
+	return values
+}
@@ -0,0 +1,98 @@
+//go:build go1.22
+
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePathFromRequest(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		UserID int    `path:"user_id"`
+		Tab    string `path:"tab"     default:"profile"`
+		Note   string `path:"-"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+		r.SetPathValue("user_id", "42")
+		r.SetPathValue("tab", "")
+
+		var s MyStruct
+		err := reqparse.ParsePathFromRequest(r, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{UserID: 42, Tab: "profile"}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/users/abc", nil)
+		r.SetPathValue("user_id", "abc")
+
+		var s MyStruct
+		err := reqparse.NewParser(nil).ParsePathFromRequest(r, &s)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"user_id": {"must be a valid integer"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("embedded struct", func(t *testing.T) {
+		t.Parallel()
+
+		type OrgParams struct {
+			OrgID int `path:"org_id"`
+		}
+
+		type EmbeddingStruct struct {
+			OrgParams
+			UserID int `path:"user_id"`
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/orgs/7/users/42", nil)
+		r.SetPathValue("org_id", "7")
+		r.SetPathValue("user_id", "42")
+
+		var s EmbeddingStruct
+		err := reqparse.ParsePathFromRequest(r, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, EmbeddingStruct{OrgParams: OrgParams{OrgID: 7}, UserID: 42}, s)
+	})
+
+	t.Run("path tag not found", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			UserID int `query:"user_id"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParsePathFromRequest(httptest.NewRequest(http.MethodGet, "/", nil), &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrPathTagNotFound)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParsePathFromRequest(httptest.NewRequest(http.MethodGet, "/", nil), s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryTarget)
+	})
+}
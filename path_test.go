@@ -0,0 +1,111 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		pathParams := map[string]string{
+			"user_id": "42",
+			"slug":    "hello-world",
+			"draft":   "false",
+		}
+
+		type MyStruct struct {
+			UserID int    `path:"user_id"`
+			Slug   string `path:"slug"`
+			Draft  *bool  `path:"draft"`
+			Format string `path:"format"  default:"json"`
+			Page   *int   `path:"page"`
+			Note   string `path:"-"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParsePath(pathParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			UserID: 42,
+			Slug:   "hello-world",
+			Draft:  newPointer(false),
+			Format: "json",
+			Page:   nil,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		pathParams := map[string]string{
+			"user_id": "abc",
+		}
+
+		type MyStruct struct {
+			UserID int    `path:"user_id"`
+			Slug   string `path:"slug"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParsePath(pathParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"user_id": {"must be a valid integer"},
+			"slug":    {"field is required"},
+		}, validationError.FieldErrors)
+		assert.Equal(
+			t,
+			"Parsing path parameters failed.\nStruct Errors:\nField Errors:\n"+
+				"\tuser_id:\n\t\tmust be a valid integer\n"+
+				"\tslug:\n\t\tfield is required\n",
+			validationError.Error(),
+		)
+	})
+
+	t.Run("path tag not found", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			UserID int `query:"user_id"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParsePath(map[string]string{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrPathTagNotFound)
+		assert.EqualError(t, err, "path tag not found for struct field: UserID")
+	})
+}
+
+func TestParsePathFromRequestPathParamsOption(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		UserID int    `path:"user_id"`
+		Tab    string `path:"tab"     default:"profile"`
+	}
+
+	opts := &reqparse.ParseQueryOptions{
+		PathParams: func(r *http.Request) map[string]string {
+			return map[string]string{"user_id": "42"}
+		},
+	}
+
+	var s MyStruct
+	err := reqparse.ParsePathFromRequest(httptest.NewRequest(http.MethodGet, "/", nil), &s, opts)
+
+	require.NoError(t, err)
+	assert.Equal(t, MyStruct{UserID: 42, Tab: "profile"}, s)
+}
@@ -0,0 +1,50 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sync"
+)
+
+// patterns caches the regular expressions compiled by [compilePattern]. Keys are the expressions
+// and values are *regexp.Regexp.
+var patterns sync.Map //nolint:gochecknoglobals
+
+// compilePattern compiles the regular expression of the `pattern` tag or the "pattern" constraint
+// of the `validate` tag. Expressions are compiled once and shared by all fields using them.
+func compilePattern(expr string) (*regexp.Regexp, error) {
+	if re, ok := patterns.Load(expr); ok {
+		return re.(*regexp.Regexp), nil //nolint:forcetypeassert
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := patterns.LoadOrStore(expr, re)
+
+	return actual.(*regexp.Regexp), nil //nolint:forcetypeassert
+}
+
+// patternRule returns the [Pattern] rule of the `pattern` tag of the field, e.g.
+// `pattern:"^[a-z0-9_-]+$"`. ok is false if there is no such tag. The tag is allowed only on string
+// fields and slices and pointers of them, and an invalid regular expression causes
+// [ErrInvalidTag] error.
+func patternRule(
+	structField reflect.StructField,
+	converters []Converter,
+) (rule Rule, ok bool, err error) {
+	expr, ok := structField.Tag.Lookup("pattern")
+	if !ok {
+		return nil, false, nil
+	}
+
+	re, err := compilePattern(expr)
+	if err != nil || fieldValueType(structField.Type, converters).Kind() != reflect.String {
+		return nil, false, fmt.Errorf("%w: pattern:%q (%s)", ErrInvalidTag, expr, structField.Name)
+	}
+
+	return Pattern(re), true, nil
+}
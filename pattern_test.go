@@ -0,0 +1,84 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryPatternTag(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Slug  string    `pattern:"^[a-z0-9_-]+$"   query:"slug"`
+		Tags  []string  `pattern:"^[a-z]+$"        query:"tag"`
+		Ref   *string   `pattern:"^[A-Z]{3}-\\d+$" query:"ref"  validate:"max=8"`
+		Codes *[]string `pattern:"^[a-z0-9_-]+$"   query:"code"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"slug": {"my-post_1"},
+			"tag":  {"go", "api"},
+			"ref":  {"ABC-12"},
+			"code": {"a-1"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		ref := "ABC-12"
+		assert.Equal(t, MyStruct{
+			Slug:  "my-post_1",
+			Tags:  []string{"go", "api"},
+			Ref:   &ref,
+			Codes: &[]string{"a-1"},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"slug": {"My Post"},
+			"tag":  {"go", "API"},
+			"ref":  {"ABC-123456"},
+			"code": {"a/1"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"slug": {"does not match required pattern"},
+			"tag":  {"(Index: 1) does not match required pattern"},
+			"ref":  {"must be at most 8 characters long"},
+			"code": {"(Index: 0) does not match required pattern"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid pattern tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				ID int `pattern:"^[0-9]+$" query:"id"`
+			}{},
+			&struct {
+				Slug string `pattern:"[a-z" query:"slug"`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
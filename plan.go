@@ -0,0 +1,1016 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// structPlans caches the plans created by [cachedStructPlan]. Keys are planKey values and values
+// are *structPlan values.
+var structPlans sync.Map //nolint:gochecknoglobals
+
+// planKey identifies a struct plan. It contains the struct type, the parts of the source and the
+// options that the plan depends on.
+type planKey struct {
+	structType         reflect.Type
+	tagName            string
+	allowFiles         bool
+	presenceBools      bool
+	rejectControlChars bool
+	boolMode           BoolMode
+	decimalSeparator   string
+	hasValidator       bool
+}
+
+// cachedStructPlan returns the plan of structType for binding it from the source. Plans are
+// created with [newStructPlan] once per struct type, source and options, and reused by the later
+// calls. Plans with errors are not cached.
+func cachedStructPlan(
+	structType reflect.Type,
+	source bindingSource,
+	opts *ParseQueryOptions,
+) (*structPlan, error) {
+	if err := checkOptions(opts); err != nil {
+		return nil, err
+	}
+
+	// Plans depending on the converters of the options are not cached since converters can't be
+	// compared.
+	if len(opts.Converters) > 0 {
+		return newStructPlan(structType, source, opts)
+	}
+
+	key := planKey{
+		structType:         structType,
+		tagName:            source.tagName,
+		allowFiles:         source.allowFiles,
+		presenceBools:      opts.PresenceBools,
+		rejectControlChars: opts.RejectControlChars,
+		boolMode:           opts.BoolMode,
+		decimalSeparator:   opts.DecimalSeparator,
+		hasValidator:       opts.Validator != nil,
+	}
+
+	if plan, ok := structPlans.Load(key); ok {
+		return plan.(*structPlan), nil //nolint:forcetypeassert
+	}
+
+	plan, err := newStructPlan(structType, source, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := structPlans.LoadOrStore(key, plan)
+
+	return actual.(*structPlan), nil //nolint:forcetypeassert
+}
+
+// checkOptions returns an error if an option is invalid.
+func checkOptions(opts *ParseQueryOptions) error {
+	if err := checkMapKeyStyle(opts); err != nil {
+		return err
+	}
+
+	if err := checkMultiValuePolicy(opts); err != nil {
+		return err
+	}
+
+	if err := checkBoolMode(opts); err != nil {
+		return err
+	}
+
+	return checkDecimalSeparator(opts)
+}
+
+// checkMapKeyStyle returns an error if the MapKeyStyle option is invalid.
+func checkMapKeyStyle(opts *ParseQueryOptions) error {
+	switch opts.MapKeyStyle {
+	case "", MapKeyStyleBracket, MapKeyStyleDot:
+		return nil
+	default:
+		return fmt.Errorf("%w: MapKeyStyle %q", ErrInvalidOption, opts.MapKeyStyle)
+	}
+}
+
+// structPlan is the result of analyzing a struct type bound from a source. It contains the plans
+// of the fields bound from the source, so binding values into the struct doesn't look up the
+// struct tags or check the field types again. A structPlan is safe for concurrent use.
+type structPlan struct {
+	structType reflect.Type
+	fields     []fieldPlan
+
+	// catchAllIndex is the index of the field tagged with "*" (e.g. `query:"*"`), which receives
+	// the values whose keys are not matched by the other fields. It is -1 if there is no such
+	// field.
+	catchAllIndex int
+}
+
+// newStructPlan analyzes the fields of structType, which must be a struct type, for binding them
+// from the source. It returns an error if a field has no tag of the source, has a type that is not
+// allowed for the source or has an invalid struct tag. The plan depends only on the tag name and
+// the allowed files of the source, and the options compared by [planKey].
+func newStructPlan(
+	structType reflect.Type,
+	source bindingSource,
+	opts *ParseQueryOptions,
+) (*structPlan, error) {
+	return newNestedStructPlan(structType, keyPath{}, source, opts, nil)
+}
+
+// keyPath describes how the keys of the fields of a nested struct are built from the key of the
+// nested struct field.
+type keyPath struct {
+	// parentKey is the key of the nested struct field. It is empty for the top-level struct.
+	parentKey string
+
+	// deepObject selects the bracket notation of the OpenAPI deepObject style, e.g.
+	// "filter[status]", instead of the dotted keys, e.g. "filter.status".
+	deepObject bool
+}
+
+// fieldKey returns the key of the field whose tag value is key.
+func (k keyPath) fieldKey(key string) string {
+	switch {
+	case k.parentKey == "":
+		return key
+	case k.deepObject:
+		return k.parentKey + "[" + key + "]"
+	default:
+		return k.parentKey + "." + key
+	}
+}
+
+// prefix returns the common prefix of the keys of the fields.
+func (k keyPath) prefix() string {
+	if k.deepObject {
+		return k.parentKey + "["
+	}
+
+	return k.parentKey + "."
+}
+
+// newNestedStructPlan is like [newStructPlan], but the keys of the fields are built with the key
+// path, e.g. "filter.status" for the fields of a nested struct bound from dotted keys. parents are
+// the struct types containing structType, which are used to reject recursive struct types.
+func newNestedStructPlan(
+	structType reflect.Type,
+	path keyPath,
+	source bindingSource,
+	opts *ParseQueryOptions,
+	parents []reflect.Type,
+) (*structPlan, error) {
+	for _, parent := range parents {
+		if parent == structType {
+			return nil, fmt.Errorf(
+				"%w: recursive struct type %s", ErrInvalidQueryFieldType, structType,
+			)
+		}
+	}
+
+	parents = append(parents[:len(parents):len(parents)], structType)
+
+	plan := &structPlan{
+		structType:    structType,
+		fields:        make([]fieldPlan, 0, structType.NumField()),
+		catchAllIndex: -1,
+	}
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+
+		// Fields tagged with "-" (e.g. `query:"-"`) are never bound from the source.
+		fieldKey, hasTag := structField.Tag.Lookup(source.tagName)
+		if fieldKey == "-" {
+			continue
+		}
+
+		if !hasTag && isEmbeddedStructField(structField, opts.Converters) {
+			embeddedPlan, err := newEmbeddedFieldPlan(structField, path, source, opts, parents)
+			if err != nil {
+				return nil, err
+			}
+
+			plan.fields = append(plan.fields, embeddedPlan)
+
+			continue
+		}
+
+		if !hasTag {
+			return nil, fmt.Errorf("%w: %s", source.errTagNotFound, structField.Name)
+		}
+
+		if fieldKey == catchAllKey {
+			if err := plan.setCatchAllField(structField, source, len(parents) > 1); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		fieldKey = path.fieldKey(fieldKey)
+
+		if isNestedStructField(structField, source.tagName, opts.Converters) {
+			nestedPlan, err := newNestedFieldPlan(
+				structField, fieldKey, path.deepObject, source, opts, parents,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			plan.fields = append(plan.fields, nestedPlan)
+
+			continue
+		}
+
+		if !isFieldTypeAllowedForSource(structField, source, opts.Converters) {
+			return nil, fmt.Errorf(
+				"%w: %s (%s)",
+				ErrInvalidQueryFieldType,
+				structField.Name,
+				structField.Type,
+			)
+		}
+
+		fieldPlan, err := newFieldPlan(structField, fieldKey, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldPlan.isFile = source.allowFiles && isFileFieldType(structField.Type)
+
+		// Map fields of the deepObject style structs use the bracket notation too.
+		if path.deepObject && fieldPlan.kind == reflect.Map {
+			fieldPlan.mapKeyStyle = MapKeyStyleBracket
+		}
+
+		plan.fields = append(plan.fields, fieldPlan)
+	}
+
+	return plan, nil
+}
+
+// resolvePresets appends the rules of the presets referenced by the fields, including the fields
+// of the nested and embedded structs, to their rules, so they aren't looked up while binding. Since
+// the presets come from the options, it must be used only for the plans that are not cached.
+func (p *structPlan) resolvePresets(presets map[string][]Rule) error {
+	for i := range p.fields {
+		field := &p.fields[i]
+
+		if field.nested != nil {
+			if err := field.nested.resolvePresets(presets); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if !field.hasPresets {
+			continue
+		}
+
+		rules, err := presetRules(field.presetNames, field.structField.Name, presets)
+		if err != nil {
+			return err
+		}
+
+		field.rules = append(field.rules[:len(field.rules):len(field.rules)], rules...)
+		field.hasPresets = false
+	}
+
+	return nil
+}
+
+// fieldKeys returns the keys of the fields bound from the source, including the fields of the
+// nested and embedded structs. Keys of the nested and embedded struct fields themselves are not
+// included since they have no values.
+func (p *structPlan) fieldKeys() []string {
+	keys := make([]string, 0, len(p.fields))
+
+	for i := range p.fields {
+		if p.fields[i].nested != nil {
+			keys = append(keys, p.fields[i].nested.fieldKeys()...)
+			continue
+		}
+
+		keys = append(keys, p.fields[i].key)
+	}
+
+	return keys
+}
+
+// hasKnownKeys reports whether the keys of all of the values bound by the plan are the keys of its
+// fields or the indexed array keys of its slice fields, i.e. the plan has no nested, embedded, map
+// or catch-all fields.
+func (p *structPlan) hasKnownKeys() bool {
+	if p.catchAllIndex >= 0 {
+		return false
+	}
+
+	for i := range p.fields {
+		if p.fields[i].nested != nil || p.fields[i].kind == reflect.Map {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bind binds the values of the source into the struct pointed by target, which must be a non-nil
+// pointer to a struct of the planned type. The source must be the one the plan is created for.
+func (p *structPlan) bind(
+	values map[string][]string,
+	target reflect.Value,
+	source bindingSource,
+	opts *ParseQueryOptions,
+) error {
+	validationErrors := newQueryValidationError(source.source, source.description, opts)
+
+	structElem := target.Elem()
+	if opts.Atomic {
+		structElem = reflect.New(p.structType).Elem()
+	}
+
+	boundFieldIndexes, err := p.bindFields(structElem, values, source, opts, validationErrors)
+	if err != nil {
+		return err
+	}
+
+	if p.catchAllIndex >= 0 {
+		p.populateCatchAll(structElem.Field(p.catchAllIndex), values, opts)
+		boundFieldIndexes = append(boundFieldIndexes, p.catchAllIndex)
+	}
+
+	return completeBinding(
+		target.Interface(), structElem, len(boundFieldIndexes) > 0, boundFieldIndexes,
+		validationErrors, opts, p.fieldKey,
+	)
+}
+
+// bindFields binds the values of the source into the fields of structv, which must be a struct of
+// the planned type, and returns the indexes of the bound fields.
+func (p *structPlan) bindFields(
+	structv reflect.Value,
+	values map[string][]string,
+	source bindingSource,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) ([]int, error) {
+	boundFieldIndexes := make([]int, 0, len(p.fields))
+
+	for i := range p.fields {
+		field := &p.fields[i]
+		fieldv := structv.Field(field.index)
+
+		switch {
+		case field.nested != nil:
+			err := field.populateNested(fieldv, values, source, opts, validationErrors)
+			if err != nil {
+				return nil, err
+			}
+		case field.isFile:
+			populateFileField(
+				fieldv, field.structField, field.key, source.files, validationErrors,
+			)
+		default:
+			err := field.populate(fieldv, values, opts, validationErrors)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		boundFieldIndexes = append(boundFieldIndexes, field.index)
+	}
+
+	return boundFieldIndexes, nil
+}
+
+// fieldPlan is the result of analyzing the type and the struct tags of a field bound from a
+// source. It is computed once per field and reused for binding the field, so the struct tags are
+// not looked up again.
+type fieldPlan struct {
+	// index is the index of the field in the struct.
+	index int
+
+	structField reflect.StructField
+
+	// kind is the binding kind of the field. See [bindingKind].
+	kind reflect.Kind
+
+	// key is the key of the field in the source, e.g. the query parameter name.
+	key string
+
+	castOpts castOptions
+
+	// rules are the rules of the allowed values of the field type, the validation of the field
+	// type and the `validate` tag. See [allowedValuesRule], [valueValidatorRule] and
+	// [validateRules].
+	rules []Rule
+
+	// presetNames are the names in the `preset` tag. hasPresets is false if there is no such tag.
+	presetNames []string
+	hasPresets  bool
+
+	// defaultValue is the value of the `default` tag. hasDefault is false if there is no such tag.
+	defaultValue string
+	hasDefault   bool
+
+	required bool
+
+	// unique is the handling of the duplicate values of the slice field. See
+	// [uniqueModeFromTags].
+	unique uniqueMode
+
+	// pointerToSlice reports whether the field is a pointer to a slice. See
+	// [isPointerToSliceType].
+	pointerToSlice bool
+
+	// nullable reports whether the field is a nullable type of database/sql. See [isSQLNullType].
+	nullable bool
+
+	// jsonEncoded reports whether the value of the field is a JSON document. See
+	// [isJSONEncodedField].
+	jsonEncoded bool
+
+	// suffixes are the suffixes of the `stripsuffix` tag. They are nil if there is no such tag or
+	// the field is not numeric.
+	suffixes []string
+
+	// minItems and maxItems are the bounds of the number of the values of the slice field. They
+	// are zero if there are no bounds. See [itemCountsFromTag].
+	minItems int
+	maxItems int
+
+	// delimiter splits each value of the slice field into multiple elements. It is empty if the
+	// values are not split. See [delimiterFromTags].
+	delimiter string
+
+	// multiValuePolicy is the policy selected by the `multivalue` tag of the field casted from a
+	// single value. It is empty if the policy of the options is used.
+	multiValuePolicy MultiValuePolicy
+
+	// mapKeyStyle is the map key style of the map field selected by the `style` tag. It is empty if
+	// the style of the options is used.
+	mapKeyStyle MapKeyStyle
+
+	// isFile reports whether the field is bound from the uploaded files of the source.
+	isFile bool
+
+	// nested is the plan of the nested struct of the field, whose fields are bound from the keys
+	// prefixed with the key of the field, e.g. "filter.min_price" or "filter[min_price]". It is nil
+	// if the field is not a nested struct field. See [isNestedStructField].
+	nested *structPlan
+
+	// nestedKeyPrefix is the common prefix of the keys of the fields of the nested struct, e.g.
+	// "filter." or "filter[".
+	nestedKeyPrefix string
+
+	// embedded reports whether the field is an embedded struct field without a tag of the source,
+	// whose fields are bound as if they are declared in the containing struct.
+	embedded bool
+}
+
+// newFieldPlan analyzes the struct field whose key in the source is fieldKey. It returns an error
+// if a struct tag of the field is invalid.
+func newFieldPlan(
+	structField reflect.StructField,
+	fieldKey string,
+	opts *ParseQueryOptions,
+) (fieldPlan, error) {
+	castOpts, err := newCastOptions(structField, opts)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	if err := checkEncodingTag(structField); err != nil {
+		return fieldPlan{}, err
+	}
+
+	plan := fieldPlan{
+		index:          structField.Index[len(structField.Index)-1],
+		structField:    structField,
+		kind:           bindingKind(structField.Type, opts.Converters),
+		key:            fieldKey,
+		castOpts:       castOpts,
+		required:       isRequiredField(structField),
+		nullable:       isSQLNullType(structField.Type),
+		pointerToSlice: isPointerToSliceType(structField.Type, opts.Converters),
+		jsonEncoded:    isJSONEncodedField(structField),
+	}
+
+	// The `validate` tag is left to the validator of the options if there is one.
+	if opts.Validator == nil {
+		plan.rules, err = validateRules(structField, opts.Converters)
+		if err != nil {
+			return fieldPlan{}, err
+		}
+
+		plan.minItems, plan.maxItems, err = itemCountsFromTag(
+			structField, plan.kind == reflect.Slice || plan.pointerToSlice,
+		)
+		if err != nil {
+			return fieldPlan{}, err
+		}
+	}
+
+	rule, hasPattern, err := patternRule(structField, opts.Converters)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	if hasPattern {
+		plan.rules = append([]Rule{rule}, plan.rules...)
+	}
+
+	rule, hasFormat, err := formatRule(structField, opts.Converters)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	if hasFormat {
+		plan.rules = append([]Rule{rule}, plan.rules...)
+	}
+
+	rule, hasMultipleOf, err := multipleOfRule(structField, opts.Converters)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	if hasMultipleOf {
+		plan.rules = append([]Rule{rule}, plan.rules...)
+	}
+
+	plan.unique, err = uniqueModeFromTags(
+		structField, plan.kind == reflect.Slice || plan.pointerToSlice, opts.Validator != nil,
+	)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	if rule, ok := valueValidatorRule(structField.Type, opts.Converters); ok {
+		plan.rules = append([]Rule{rule}, plan.rules...)
+	}
+
+	if rule, ok := allowedValuesRule(structField.Type, opts.Converters); ok {
+		plan.rules = append([]Rule{rule}, plan.rules...)
+	}
+
+	if presetNames, ok := structField.Tag.Lookup("preset"); ok {
+		plan.presetNames = strings.Split(presetNames, ",")
+		plan.hasPresets = true
+	}
+
+	plan.defaultValue, plan.hasDefault = structField.Tag.Lookup("default")
+
+	plan.delimiter, err = delimiterFromTags(
+		structField, plan.kind == reflect.Slice || plan.pointerToSlice,
+	)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	plan.multiValuePolicy, err = multiValuePolicyFromTag(
+		structField, plan.jsonEncoded || plan.isSingleValue(),
+	)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	// Styles of the slice fields are checked by delimiterFromTags.
+	if style, ok := structField.Tag.Lookup("style"); ok && styleDelimiters[style] == "" {
+		if style != styleDeepObject || plan.kind != reflect.Map {
+			return fieldPlan{}, fmt.Errorf(
+				"%w: style:%q (%s)", ErrInvalidTag, style, structField.Name,
+			)
+		}
+
+		plan.mapKeyStyle = MapKeyStyleBracket
+	}
+
+	suffixes, ok := structField.Tag.Lookup("stripsuffix")
+	if ok && isNumericField(structField.Type, opts.Converters) {
+		plan.suffixes = strings.Split(suffixes, ",")
+	}
+
+	return plan, nil
+}
+
+// populate finds the associated values for the field and sets the field value accordingly. It
+// handles default values, required fields, type casting and validation errors.
+func (p *fieldPlan) populate( //nolint:cyclop,funlen
+	fieldv reflect.Value,
+	sourceValues map[string][]string,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) error {
+	if p.jsonEncoded {
+		p.populateJSON(fieldv, sourceValues, opts, validationErrors)
+		return nil
+	}
+
+	rules, err := p.resolveRules(opts)
+	if err != nil {
+		return err
+	}
+
+	if p.kind == reflect.Map {
+		populateMapFieldFromQuery(
+			fieldv,
+			p.structField,
+			p.key,
+			sourceValues,
+			p.resolvedMapKeyStyle(opts),
+			p.castOpts,
+			validationErrors,
+		)
+
+		return nil
+	}
+
+	values, present := sourceValues[p.key]
+	if len(values) == 0 && (p.kind == reflect.Slice || p.pointerToSlice) {
+		// Slices can be bound from indexed array keys too, e.g. `items[0]=a&items[1]=b`.
+		var missingIndexes []int
+
+		values, missingIndexes = indexedArrayValues(sourceValues, p.key)
+		if missingIndexes != nil {
+			for _, index := range missingIndexes {
+				validationErrors.addFieldErr(p.key, index, errMissingValue)
+			}
+
+			return nil
+		}
+	}
+
+	if p.delimiter != "" {
+		values = splitDelimitedValues(values, p.delimiter)
+	}
+
+	if p.isSingleValue() {
+		var ok bool
+		if values, ok = p.applyMultiValuePolicy(values, opts, validationErrors); !ok {
+			return nil
+		}
+	}
+
+	validationErrors.markPresence(p.key, len(values) > 0)
+
+	if len(values) == 0 {
+		if !p.hasDefault {
+			switch {
+			case p.required && !(present && p.kind == reflect.Slice):
+				// Fields with `required:"true"` tag are required regardless of their type. A
+				// present key without values, e.g. {"ids": {}}, sets an empty slice below.
+				validationErrors.addFieldErr(p.key, noIndex, errRequired)
+			case opts.PresenceBools && fieldv.Kind() == reflect.Bool:
+				// With PresenceBools option, absence of a bool field means false.
+				fieldv.SetBool(p.castOpts.negateBool)
+			case p.kind == reflect.Slice:
+				// If default value is not specified for slice field which is not present in the
+				// query params, set an empty slice.
+				fieldv.Set(reflect.MakeSlice(fieldv.Type(), 0, 0))
+				p.checkItemCount(0, validationErrors)
+			case p.kind == reflect.Pointer || p.nullable:
+				// If default value is not specified for pointer or nullable field which is not
+				// present in the query params, set nil or the invalid (null) value.
+				fieldv.Set(reflect.Zero(fieldv.Type()))
+			default:
+				// If default value is not specified for other type of field which is not present in
+				// the query params, add a validation error to indicate that the field is required.
+				validationErrors.addFieldErr(p.key, noIndex, errRequired)
+			}
+
+			return nil
+		}
+
+		// Empty default value of a pointer or nullable field explicitly means nil or null.
+		if (p.kind == reflect.Pointer || p.nullable) && p.defaultValue == "" {
+			fieldv.Set(reflect.Zero(fieldv.Type()))
+			return nil
+		}
+
+		switch {
+		case p.delimiter != "":
+			// Default values of the fields with a delimiter are split by the delimiter.
+			values = strings.Split(p.defaultValue, p.delimiter)
+		case p.kind == reflect.Slice || p.pointerToSlice:
+			values = strings.Split(p.defaultValue, ",")
+		default:
+			values = []string{p.defaultValue}
+		}
+	}
+
+	if p.suffixes != nil {
+		values = stripValueSuffixes(values, p.suffixes)
+	}
+
+	// Set the field value by the query values
+	var casted bool
+
+	switch p.kind { //nolint:exhaustive
+	case reflect.Slice:
+		casted = setSliceFieldValue(fieldv, values, p.castOpts, p.key, validationErrors)
+
+	case reflect.Pointer:
+		casted = setPointerFieldValue(fieldv, values, p.castOpts, p.key, validationErrors)
+
+	default:
+		castedValue, err := castQueryValue(fieldv.Type(), values[0], p.castOpts)
+		if err != nil {
+			validationErrors.addFieldErr(p.key, noIndex, err)
+			break
+		}
+
+		fieldv.Set(castedValue)
+
+		casted = true
+	}
+
+	// Following validations are applied only if all of the values are casted successfully.
+	if casted {
+		p.validateValue(fieldv, rules, validationErrors)
+	}
+
+	return nil
+}
+
+// isOptional reports whether an absent value of the field is allowed without a default value,
+// i.e. the field is a slice, pointer or nullable field without `required:"true"` tag.
+func (p *fieldPlan) isOptional() bool {
+	return !p.required && !p.hasDefault &&
+		(p.kind == reflect.Slice || p.kind == reflect.Pointer || p.nullable)
+}
+
+// resolveRules returns the rules applied to the values of the field. Rules of the presets are
+// applied after the rules of the `validate` tag, and the field validators of the options after
+// them.
+func (p *fieldPlan) resolveRules(opts *ParseQueryOptions) ([]Rule, error) {
+	rules := p.rules
+
+	if p.hasPresets {
+		presets, err := presetRules(p.presetNames, p.structField.Name, opts.Presets)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules[:len(rules):len(rules)], presets...)
+	}
+
+	if fieldValidators := opts.FieldValidators[p.key]; len(fieldValidators) > 0 {
+		rules = append(rules[:len(rules):len(rules)], fieldValidators...)
+	}
+
+	return rules, nil
+}
+
+// validateValue checks the value of the field set from the source: the uniqueness and the number
+// of the values of slice fields, and the rules.
+func (p *fieldPlan) validateValue(
+	fieldv reflect.Value,
+	rules []Rule,
+	validationErrors *QueryValidationError,
+) {
+	if slicev, ok := p.sliceValue(fieldv); ok {
+		switch p.unique {
+		case uniqueError:
+			if hasDuplicateElements(slicev) {
+				validationErrors.addFieldErr(p.key, noIndex, errNotUnique)
+			}
+		case uniqueDedupe:
+			slicev.Set(removeDuplicateElements(slicev))
+		case uniqueNone:
+		}
+
+		p.checkItemCount(slicev.Len(), validationErrors)
+	}
+
+	applyRules(fieldv, rules, p.key, validationErrors)
+}
+
+// sliceValue returns the slice of the slice or pointer to slice field. It returns false for the
+// other fields.
+func (p *fieldPlan) sliceValue(fieldv reflect.Value) (reflect.Value, bool) {
+	switch {
+	case p.kind == reflect.Slice:
+		return fieldv, true
+	case p.pointerToSlice:
+		return fieldv.Elem(), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// checkItemCount reports a validation error if the number of the values of the slice field is out
+// of the bounds of the `validate` tag.
+func (p *fieldPlan) checkItemCount(count int, validationErrors *QueryValidationError) {
+	switch {
+	case count < p.minItems:
+		validationErrors.addFieldErr(p.key, noIndex, newCodedError(
+			CodeMinItems,
+			"must have at least "+valueCount(p.minItems),
+			map[string]any{"min": p.minItems},
+		))
+	case p.maxItems > 0 && count > p.maxItems:
+		validationErrors.addFieldErr(p.key, noIndex, newCodedError(
+			CodeMaxItems,
+			"must have at most "+valueCount(p.maxItems),
+			map[string]any{"max": p.maxItems},
+		))
+	}
+}
+
+// valueCount returns the number of values in words, e.g. "1 value" or "3 values".
+func valueCount(n int) string {
+	if n == 1 {
+		return "1 value"
+	}
+
+	return strconv.Itoa(n) + " values"
+}
+
+// populateNested binds the values of the source into the nested struct field. A pointer field is
+// set to nil if none of the keys of the nested struct are present in the source. Embedded pointer
+// fields are always set to a new struct.
+func (p *fieldPlan) populateNested(
+	fieldv reflect.Value,
+	values map[string][]string,
+	source bindingSource,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) error {
+	if p.kind != reflect.Pointer {
+		_, err := p.nested.bindFields(fieldv, values, source, opts, validationErrors)
+		return err
+	}
+
+	if !p.embedded && !hasKeyWithPrefix(values, p.nestedKeyPrefix) {
+		if p.required {
+			validationErrors.addFieldErr(p.key, noIndex, errRequired)
+			return nil
+		}
+
+		fieldv.Set(reflect.Zero(fieldv.Type()))
+
+		return nil
+	}
+
+	nestedv := reflect.New(fieldv.Type().Elem())
+
+	_, err := p.nested.bindFields(nestedv.Elem(), values, source, opts, validationErrors)
+	if err != nil {
+		return err
+	}
+
+	fieldv.Set(nestedv)
+
+	return nil
+}
+
+// newNestedFieldPlan analyzes the nested struct field whose key in the source is fieldKey. Its
+// fields use the bracket notation if the `style:"deepObject"` tag is set or deepObject is true,
+// i.e. the field is in a deepObject style struct.
+func newNestedFieldPlan(
+	structField reflect.StructField,
+	fieldKey string,
+	deepObject bool,
+	source bindingSource,
+	opts *ParseQueryOptions,
+	parents []reflect.Type,
+) (fieldPlan, error) {
+	if style, ok := structField.Tag.Lookup("style"); ok {
+		if style != styleDeepObject {
+			return fieldPlan{}, fmt.Errorf(
+				"%w: style:%q (%s)", ErrInvalidTag, style, structField.Name,
+			)
+		}
+
+		deepObject = true
+	}
+
+	nestedType := structField.Type
+	if nestedType.Kind() == reflect.Pointer {
+		nestedType = nestedType.Elem()
+	}
+
+	path := keyPath{parentKey: fieldKey, deepObject: deepObject}
+
+	nested, err := newNestedStructPlan(nestedType, path, source, opts, parents)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	return fieldPlan{
+		index:           structField.Index[len(structField.Index)-1],
+		structField:     structField,
+		kind:            structField.Type.Kind(),
+		key:             fieldKey,
+		required:        isRequiredField(structField),
+		nested:          nested,
+		nestedKeyPrefix: path.prefix(),
+	}, nil
+}
+
+// newEmbeddedFieldPlan analyzes the embedded struct field without a tag of the source. Its fields
+// are bound with the same key path as the fields of the containing struct.
+func newEmbeddedFieldPlan(
+	structField reflect.StructField,
+	path keyPath,
+	source bindingSource,
+	opts *ParseQueryOptions,
+	parents []reflect.Type,
+) (fieldPlan, error) {
+	embeddedType := structField.Type
+	if embeddedType.Kind() == reflect.Pointer {
+		// The pointer of an embedded field of an unexported type can't be set.
+		if !structField.IsExported() {
+			return fieldPlan{}, fmt.Errorf(
+				"%w: %s (%s)", ErrInvalidQueryFieldType, structField.Name, structField.Type,
+			)
+		}
+
+		embeddedType = embeddedType.Elem()
+	}
+
+	nested, err := newNestedStructPlan(embeddedType, path, source, opts, parents)
+	if err != nil {
+		return fieldPlan{}, err
+	}
+
+	return fieldPlan{
+		index:       structField.Index[len(structField.Index)-1],
+		structField: structField,
+		kind:        structField.Type.Kind(),
+		key:         path.parentKey,
+		nested:      nested,
+		embedded:    true,
+	}, nil
+}
+
+// isEmbeddedStructField reports whether the field is an embedded struct or pointer to struct field
+// whose fields are bound as if they are declared in the containing struct.
+func isEmbeddedStructField(structField reflect.StructField, converters []Converter) bool {
+	fieldType := structField.Type
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	return structField.Anonymous && fieldType.Kind() == reflect.Struct &&
+		!isValueTypeAllowedForQueryParsing(fieldType, converters)
+}
+
+// isNestedStructField reports whether the field is a struct or pointer to struct field whose
+// fields are bound from dotted keys. The struct type must have at least one field with the tag of
+// the source, must not be a type casted from a single value such as time.Time, and the field must
+// not be JSON encoded.
+func isNestedStructField(
+	structField reflect.StructField,
+	tagName string,
+	converters []Converter,
+) bool {
+	fieldType := structField.Type
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	if fieldType.Kind() != reflect.Struct || isJSONEncodedField(structField) ||
+		isValueTypeAllowedForQueryParsing(fieldType, converters) {
+		return false
+	}
+
+	for i := 0; i < fieldType.NumField(); i++ {
+		if _, ok := fieldType.Field(i).Tag.Lookup(tagName); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasKeyWithPrefix reports whether any of the keys with values starts with the prefix.
+func hasKeyWithPrefix(values map[string][]string, prefix string) bool {
+	for key, keyValues := range values {
+		if len(keyValues) > 0 && strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSingleValue reports whether the field is casted from a single value, i.e. it is not a slice,
+// pointer to slice or map field.
+func (p *fieldPlan) isSingleValue() bool {
+	return (p.kind == reflect.Invalid || p.kind == reflect.Pointer) && !p.pointerToSlice
+}
+
+// resolvedMapKeyStyle returns the map key style of the map field: the style selected by its
+// `style` tag, or the style of the options.
+func (p *fieldPlan) resolvedMapKeyStyle(opts *ParseQueryOptions) MapKeyStyle {
+	if p.mapKeyStyle != "" {
+		return p.mapKeyStyle
+	}
+
+	return opts.MapKeyStyle
+}
@@ -0,0 +1,65 @@
+package reqparse
+
+import "net/http"
+
+// ProblemContentType is the media type of [ProblemDetails] documents.
+const ProblemContentType = "application/problem+json"
+
+// ProblemDetails is a problem details document as defined by RFC 7807, created by
+// [QueryValidationError.ToProblemDetails]. The validation errors are in the "errors" extension
+// member.
+type ProblemDetails struct {
+	// Type is a URI reference identifying the problem type. "about:blank" means the problem has no
+	// semantics beyond the status code.
+	Type string `json:"type"`
+
+	// Title is a short summary of the problem type, e.g. "Bad Request".
+	Title string `json:"title"`
+
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Detail is an explanation specific to this occurrence of the problem, e.g. "Parsing query
+	// parameters failed.".
+	Detail string `json:"detail,omitempty"`
+
+	// Instance is a URI reference identifying this occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+
+	// Errors are the field errors in [QueryValidationError.SortedFieldErrors] order, followed by
+	// the struct errors, which have no field and have the [CodeInvalid] code and the source of the
+	// validation error.
+	Errors []FieldError `json:"errors"`
+}
+
+// ToProblemDetails returns the RFC 7807 problem details document of the validation error with
+// the given status code, problem type and title, to be written as an "application/problem+json"
+// response, see [ProblemContentType]. An empty problem type means "about:blank" and an empty title
+// means the status text of the status code, e.g. "Bad Request".
+func (e *QueryValidationError) ToProblemDetails(
+	status int,
+	problemType string,
+	title string,
+) *ProblemDetails {
+	if problemType == "" {
+		problemType = "about:blank"
+	}
+
+	if title == "" {
+		title = http.StatusText(status)
+	}
+
+	errs := e.SortedFieldErrors()
+
+	for _, message := range e.StructErrors {
+		errs = append(errs, FieldError{Code: CodeInvalid, Message: message, Source: e.Source})
+	}
+
+	return &ProblemDetails{
+		Type:   problemType,
+		Title:  title,
+		Status: status,
+		Detail: e.summary(),
+		Errors: errs,
+	}
+}
@@ -0,0 +1,83 @@
+package reqparse_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryValidationErrorToProblemDetails(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Page     int      `query:"page"      validate:"min=1"`
+		IDs      []int    `query:"id"`
+		MinPrice *float64 `query:"min_price"`
+		MaxPrice *float64 `query:"max_price" validate:"gtfield=MinPrice"`
+	}
+
+	var s MyStruct
+	err := reqparse.ParseQuery(map[string][]string{
+		"page":      {"0"},
+		"id":        {"1", "x"},
+		"min_price": {"20"},
+		"max_price": {"10"},
+	}, &s, nil)
+
+	var validationErr *reqparse.QueryValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	t.Run("document", func(t *testing.T) {
+		t.Parallel()
+
+		problem := validationErr.ToProblemDetails(
+			http.StatusUnprocessableEntity,
+			"https://example.com/problems/validation",
+			"Invalid request",
+		)
+
+		encoded, marshalErr := json.Marshal(problem)
+		require.NoError(t, marshalErr)
+		assert.JSONEq(t, `{
+			"type": "https://example.com/problems/validation",
+			"title": "Invalid request",
+			"status": 422,
+			"detail": "Parsing query parameters failed.",
+			"errors": [
+				{
+					"field": "page",
+					"code": "min",
+					"message": "must be greater than or equal to 1",
+					"params": {"min": 1},
+					"source": "query"
+				},
+				{
+					"field": "id",
+					"code": "invalid_integer",
+					"message": "must be a valid integer",
+					"index": 1,
+					"source": "query"
+				},
+				{
+					"code": "invalid",
+					"message": "max_price must be greater than min_price",
+					"source": "query"
+				}
+			]
+		}`, string(encoded))
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		problem := validationErr.ToProblemDetails(http.StatusBadRequest, "", "")
+		assert.Equal(t, "about:blank", problem.Type)
+		assert.Equal(t, "Bad Request", problem.Title)
+		assert.Equal(t, http.StatusBadRequest, problem.Status)
+		assert.Len(t, problem.Errors, 3)
+	})
+}
@@ -1,11 +1,18 @@
 package reqparse
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/netip"
 	"reflect"
+	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 var (
@@ -14,35 +21,242 @@ var (
 	)
 	ErrInvalidQueryFieldType = errors.New("field type is not allowed for query parsing")
 	ErrQueryTagNotFound      = errors.New("query tag not found for struct field")
+	ErrPathTagNotFound       = errors.New("path tag not found for struct field")
+	ErrFormTagNotFound       = errors.New("form tag not found for struct field")
+	ErrRequestTagNotFound    = errors.New(
+		"query, path, header, cookie or json tag not found for struct field",
+	)
+	ErrNoBindableQueryFields = errors.New("target struct has no fields bound to query parameters")
+	ErrUnknownPreset         = errors.New("unknown validation preset")
+	ErrInvalidTag            = errors.New("invalid struct tag value")
+	ErrInvalidOption         = errors.New("invalid parse option")
+	ErrUnsupportedMediaType  = errors.New("unsupported media type")
+	ErrAuthorizationNotFound = errors.New("authorization header not found")
+	ErrInvalidAuthorization  = errors.New("invalid authorization header")
+	ErrHeaderTagNotFound     = errors.New("header tag not found for struct field")
+	ErrInvalidRange          = errors.New("invalid range header")
+	ErrRangeNotSatisfiable   = errors.New("range not satisfiable")
+	ErrInvalidETag           = errors.New("invalid entity tag list")
+	ErrInvalidForwarded      = errors.New("invalid forwarded header")
+	ErrInvalidAcceptLanguage = errors.New("invalid accept-language header")
+	ErrInvalidLink           = errors.New("invalid link header")
+	ErrInvalidDisposition    = errors.New("invalid content-disposition header")
+	ErrInvalidUUID           = errors.New("invalid UUID")
 )
 
 // QueryValidationError is the error type used by [ParseQuery] function when the passed query
-// parameters does not satisfy the validation rules of the struct.
+// parameters does not satisfy the validation rules of the struct. The other parsers of the package
+// report their validation errors with the same type, see [ValidationError].
 type QueryValidationError struct {
+	// Source is the source of the parsed values, e.g. [SourceQuery] or [SourceBody].
+	Source Source
+
 	// FieldErrors contains errors for fields that have at least one error. Key is the query name of
 	// the field.
 	FieldErrors map[string][]string
 
-	// StructErrors contains struct level validation errors.
-	//
-	// INFO: This field is not implemented at the moment, so it will always be empty. When
-	// implemented, it will contain struct level validation errors.
+	// StructErrors contains validation errors that are not specific to a field, e.g. malformed
+	// request body or the errors of the struct validators, see [QueryStructValidator].
 	StructErrors []string
+
+	// state is the state of the validation error that is not part of its value, see
+	// [validationState]. It is nil for the errors returned from the parsers, whose state is kept in
+	// returnedStates, so they are equal to the values built from their FieldErrors and
+	// StructErrors.
+	state *validationState
+}
+
+// validationState is the state of a [QueryValidationError] recorded while its errors are added.
+type validationState struct {
+	// sourceDescription is the description of the parsed values used in the error text, e.g.
+	// "query parameters". Empty means "query parameters".
+	sourceDescription string
+
+	// fieldOrder contains the keys of FieldErrors in the order their first errors are recorded,
+	// reordered by the declaration order of the struct fields after binding, see
+	// [QueryValidationError.sortFieldOrder].
+	fieldOrder []string
+
+	// fieldErrorList contains the field errors in the order they are recorded.
+	fieldErrorList []FieldError
+
+	// localize returns the message of the validation error of the field, see
+	// [ParseQueryOptions.localizeMessage]. It is nil if the default messages are used.
+	localize func(
+		fieldKey string,
+		index int,
+		code ErrorCode,
+		params map[string]any,
+		defaultMessage string,
+	) (message string, custom bool)
+
+	// fieldSource is the source of the field errors being recorded if it differs from Source, e.g.
+	// the source of the field being bound by [ParseRequest].
+	fieldSource Source
+
+	// keyPresence reports whether the values of the fields are present in the source, keyed by
+	// the keys of the fields and recorded while binding. Unlike the zero values of the fields, it
+	// tells the explicitly supplied zero values, e.g. "count=0", from the absent values.
+	keyPresence map[string]bool
+
+	// detailedErrors reports whether the casting errors include the received values and the
+	// expected types, see [ParseQueryOptions.DetailedErrors].
+	detailedErrors bool
+
+	// maxErrorsPerField and maxTotalErrors are the limits of the recorded errors, see
+	// [ParseQueryOptions.MaxErrorsPerField] and [ParseQueryOptions.MaxTotalErrors]. truncated
+	// reports whether any error is dropped because of them.
+	maxErrorsPerField int
+	maxTotalErrors    int
+	truncated         bool
+}
+
+// FieldError is a validation error of a field with a machine-readable code, see
+// [QueryValidationError.FieldErrorList].
+type FieldError struct {
+	// Field is the key of the field, e.g. the query name.
+	Field string `json:"field,omitempty"`
+
+	// Code is the code of the error, e.g. [CodeInvalidInteger]. Errors of custom rules and
+	// converters have the [CodeInvalid] code.
+	Code ErrorCode `json:"code"`
+
+	// Message is the message of the error, without the index prefix of the messages of
+	// FieldErrors, e.g. "must be a valid integer".
+	Message string `json:"message"`
+
+	// Index is the index of the slice element the error is reported for, or nil if the error is
+	// not specific to an element.
+	Index *int `json:"index,omitempty"`
+
+	// Params are the parameters of the error, e.g. {"min": 1} for [CodeMin]. It is nil if the
+	// error has no parameters.
+	Params map[string]any `json:"params,omitempty"`
+
+	// Value is the received value that can't be casted to the type of the field, e.g. "abc", and
+	// Expected is the description of the type, e.g. "integer". They are set only for the casting
+	// errors if [ParseQueryOptions.DetailedErrors] is set.
+	Value    string `json:"value,omitempty"`
+	Expected string `json:"expected,omitempty"`
+
+	// Source is the source of the value of the field, e.g. [SourceHeader]. Errors of
+	// [ParseRequest] that are not specific to a source, e.g. the errors of the `required_if` tags,
+	// have the [SourceRequest] source.
+	Source Source `json:"source,omitempty"`
+}
+
+// noIndex is the index of the field errors that are not specific to an element of a slice.
+const noIndex = -1
+
+// errRequired is the validation error of the missing required fields.
+var errRequired = newCodedError(CodeRequired, "field is required", nil)
+
+// newQueryValidationError creates the validation error collecting the errors of the values parsed
+// from the source, whose description is used in the error text, e.g. "query parameters". Messages
+// are customized with the MessageFunc and MessageCatalog options.
+func newQueryValidationError(
+	source Source,
+	sourceDescription string,
+	opts *ParseQueryOptions,
+) *QueryValidationError {
+	state := &validationState{
+		sourceDescription: sourceDescription,
+		detailedErrors:    opts.DetailedErrors,
+		maxErrorsPerField: opts.MaxErrorsPerField,
+		maxTotalErrors:    opts.MaxTotalErrors,
+	}
+	if opts.MessageFunc != nil || (opts.MessageCatalog != nil && opts.Language != "") {
+		state.localize = opts.localizeMessage
+	}
+
+	return &QueryValidationError{Source: source, state: state}
+}
+
+// returnedStates contains the states of the validation errors returned from the parsers, keyed by
+// the addresses of the errors. Entries are removed when their errors are garbage collected, see
+// [QueryValidationError.err].
+var returnedStates sync.Map //nolint:gochecknoglobals
+
+// currentState returns the state of the validation error. It returns an empty state without
+// storing it if the error has no state yet, e.g. errors built outside the package.
+func (e *QueryValidationError) currentState() *validationState {
+	if e.state != nil {
+		return e.state
+	}
+
+	if state, ok := e.returnedState(); ok {
+		return state
+	}
+
+	return &validationState{}
+}
+
+// mutableState returns the state of the validation error to record the errors in, storing a new
+// state if the error has no state yet.
+func (e *QueryValidationError) mutableState() *validationState {
+	if e.state != nil {
+		return e.state
+	}
+
+	if state, ok := e.returnedState(); ok {
+		return state
+	}
+
+	e.state = &validationState{}
+
+	return e.state
+}
+
+// returnedState returns the state of the validation error returned from a parser, see
+// [QueryValidationError.err].
+func (e *QueryValidationError) returnedState() (*validationState, bool) {
+	state, ok := returnedStates.Load(reflect.ValueOf(e).Pointer())
+	if !ok {
+		return nil, false
+	}
+
+	return state.(*validationState), true //nolint:forcetypeassert
+}
+
+// FieldErrorKeys returns the keys of FieldErrors in the declaration order of the struct fields
+// for errors returned from the parsers, or in the order their first errors are recorded for errors
+// built with [QueryValidationError.AddFieldError]. Keys of FieldErrors that are not recorded by the
+// package (e.g. added manually) are placed at the end in sorted order.
+func (e *QueryValidationError) FieldErrorKeys() []string {
+	keys := make([]string, 0, len(e.FieldErrors))
+	seen := make(map[string]struct{}, len(e.FieldErrors))
+
+	for _, key := range e.currentState().fieldOrder {
+		if _, ok := e.FieldErrors[key]; ok {
+			keys = append(keys, key)
+			seen[key] = struct{}{}
+		}
+	}
+
+	unorderedKeys := make([]string, 0, len(e.FieldErrors)-len(keys))
+	for key := range e.FieldErrors { //nolint:wsl
+		if _, ok := seen[key]; !ok {
+			unorderedKeys = append(unorderedKeys, key)
+		}
+	}
+	sort.Strings(unorderedKeys) //nolint:wsl
+
+	return append(keys, unorderedKeys...)
 }
 
 func (e *QueryValidationError) Error() string {
 	var errText strings.Builder
 
-	errText.WriteString("Parsing query parameters failed.\nStruct Errors:\n")
+	errText.WriteString(e.summary() + "\nStruct Errors:\n")
 	for _, err := range e.StructErrors { //nolint:wsl
 		errText.WriteString("\t" + err + "\n")
 	}
 
 	errText.WriteString("Field Errors:\n")
-	for k, v := range e.FieldErrors { //nolint:wsl
+	for _, k := range e.FieldErrorKeys() { //nolint:wsl
 		errText.WriteString("\t" + k + ":\n")
 
-		for _, err := range v {
+		for _, err := range e.FieldErrors[k] {
 			errText.WriteString("\t\t" + err + "\n")
 		}
 	}
@@ -50,284 +264,876 @@ func (e *QueryValidationError) Error() string {
 	return errText.String()
 }
 
-// ParseQueryOptions is the options type for [ParseQuery]. It will be used in the future for
-// adding custom validators to [ParseQuery] and other stuff.
-type ParseQueryOptions struct{}
+// FieldErrorList returns the field errors with their codes in the order they are recorded, which
+// is the declaration order of the struct fields for errors returned from [ParseQuery]. Errors
+// appended to FieldErrors directly are not included.
+func (e *QueryValidationError) FieldErrorList() []FieldError {
+	return append([]FieldError(nil), e.currentState().fieldErrorList...)
+}
+
+// summary returns the first line of the error text, e.g. "Parsing query parameters failed.".
+func (e *QueryValidationError) summary() string {
+	sourceDescription := e.currentState().sourceDescription
+	if sourceDescription == "" {
+		sourceDescription = querySource.description
+	}
+
+	return "Parsing " + sourceDescription + " failed."
+}
+
+// validationErrorJSON is the JSON representation of [QueryValidationError].
+type validationErrorJSON struct {
+	FieldErrors  map[string][]string `json:"field_errors"`
+	StructErrors []string            `json:"struct_errors"`
+}
+
+// MarshalJSON encodes the validation error as a JSON object with the field errors and the struct
+// errors, so it can be written as the body of an error response:
+//
+//	{"field_errors": {"page": ["must be a valid integer"]}, "struct_errors": []}
+//
+// Both members are always present; they are empty if there are no such errors.
+func (e *QueryValidationError) MarshalJSON() ([]byte, error) {
+	encoded := validationErrorJSON{
+		FieldErrors:  e.FieldErrors,
+		StructErrors: e.StructErrors,
+	}
+
+	if encoded.FieldErrors == nil {
+		encoded.FieldErrors = make(map[string][]string)
+	}
+
+	if encoded.StructErrors == nil {
+		encoded.StructErrors = make([]string, 0)
+	}
+
+	return json.Marshal(encoded)
+}
+
+// AddFieldError appends the error message to the errors of the field with the given query name
+// and records the field in [QueryValidationError.FieldErrorKeys] order. The error has the
+// [CodeInvalid] code in [QueryValidationError.FieldErrorList].
+//
+// AddFieldError and [QueryValidationError.AddFieldErrorDetail] are the supported ways to build
+// validation errors outside the package, e.g. in custom validators. Unlike appending to
+// FieldErrors directly, the error is included in [QueryValidationError.FieldErrorList] and
+// counted by the error limits of the options.
+func (e *QueryValidationError) AddFieldError(fieldKey string, message string) {
+	e.addFieldError(FieldError{Field: fieldKey, Code: CodeInvalid, Message: message}, message)
+}
+
+// AddFieldErrorDetail appends the field error to the errors of its field like
+// [QueryValidationError.AddFieldError], keeping its Code, Index and Params in
+// [QueryValidationError.FieldErrorList]. If Index is set, the message in FieldErrors is prefixed
+// with the index, e.g. "(Index: 2) must be a valid integer", the same way as the errors of the
+// slice elements reported by the package. Source of the field error is set to the source of the
+// validation error.
+//
+// The parsers generated by the reqparsegen command use it to report the same errors as
+// [ParseQuery].
+func (e *QueryValidationError) AddFieldErrorDetail(fieldErr FieldError) {
+	message := fieldErr.Message
+	if fieldErr.Index != nil {
+		message = indexedMessage(*fieldErr.Index, message)
+	}
+
+	e.addFieldError(fieldErr, message)
+}
+
+// addFieldError records the field error and appends the message to the errors of its field in
+// FieldErrors.
+func (e *QueryValidationError) addFieldError(fieldErr FieldError, message string) {
+	if e.reachedLimit(len(e.FieldErrors[fieldErr.Field])) {
+		return
+	}
+
+	if e.FieldErrors == nil {
+		e.FieldErrors = make(map[string][]string)
+	}
+
+	state := e.mutableState()
+	if _, ok := e.FieldErrors[fieldErr.Field]; !ok {
+		state.fieldOrder = append(state.fieldOrder, fieldErr.Field)
+	}
+
+	fieldErr.Source = e.Source
+	if state.fieldSource != "" {
+		fieldErr.Source = state.fieldSource
+	}
+
+	e.FieldErrors[fieldErr.Field] = append(e.FieldErrors[fieldErr.Field], message)
+	state.fieldErrorList = append(state.fieldErrorList, fieldErr)
+}
+
+// markPresence records whether the value of the field with the given key is present in the
+// source.
+func (e *QueryValidationError) markPresence(fieldKey string, present bool) {
+	state := e.mutableState()
+	if state.keyPresence == nil {
+		state.keyPresence = make(map[string]bool)
+	}
+
+	state.keyPresence[fieldKey] = present
+}
+
+// presence reports whether the value of the field with the given key is present in the source.
+// recorded is false if the binders didn't record the presence of the field, e.g. the fields of
+// the structs decoded from JSON as a whole.
+func (e *QueryValidationError) presence(fieldKey string) (present bool, recorded bool) {
+	present, recorded = e.currentState().keyPresence[fieldKey]
+	return present, recorded
+}
+
+// addStructError appends the error message to the struct errors.
+func (e *QueryValidationError) addStructError(message string) {
+	if e.reachedLimit(0) {
+		return
+	}
+
+	e.StructErrors = append(e.StructErrors, message)
+}
+
+// reachedLimit reports whether a new error of a field with the given number of errors exceeds the
+// limits of the options, and marks the errors truncated if so.
+func (e *QueryValidationError) reachedLimit(fieldErrorCount int) bool {
+	state := e.currentState()
+	if (state.maxErrorsPerField > 0 && fieldErrorCount >= state.maxErrorsPerField) ||
+		(state.maxTotalErrors > 0 &&
+			len(state.fieldErrorList)+len(e.StructErrors) >= state.maxTotalErrors) {
+		e.mutableState().truncated = true
+		return true
+	}
+
+	return false
+}
+
+// Truncated reports whether some of the errors are dropped because of the MaxErrorsPerField and
+// MaxTotalErrors options.
+func (e *QueryValidationError) Truncated() bool {
+	return e.currentState().truncated
+}
+
+// addFieldErr appends the message of the validation error to the errors of the field. If index is
+// not [noIndex], the error is reported for the element of the slice field at the index, e.g.
+// "(Index: 2) must be a valid integer".
+func (e *QueryValidationError) addFieldErr(fieldKey string, index int, err error) {
+	code, params := errorCodeOf(err)
+	fieldErr := FieldError{Field: fieldKey, Code: code, Params: cloneParams(params, 0)}
+	defaultMessage := err.Error()
+
+	var castErr *castError
+	if e.currentState().detailedErrors && errors.As(err, &castErr) {
+		fieldErr.Value = castErr.value
+		fieldErr.Expected = castErr.expected()
+		defaultMessage = detailedMessage(defaultMessage, fieldErr.Value, fieldErr.Expected)
+		params = detailParams(params, fieldErr.Value, fieldErr.Expected)
+	}
+
+	message, custom := e.message(fieldKey, index, code, params, defaultMessage)
+	fieldErr.Message = message
+
+	if index != noIndex {
+		fieldErr.Index = &index
+
+		if !custom {
+			message = indexedMessage(index, message)
+		}
+	}
+
+	e.addFieldError(fieldErr, message)
+}
+
+// addStructErr appends the message of the validation error to the struct errors.
+func (e *QueryValidationError) addStructErr(err error) {
+	code, params := errorCodeOf(err)
+	message, _ := e.message("", noIndex, code, params, err.Error())
+	e.addStructError(message)
+}
+
+// message returns the message of the validation error of the field with the code and the
+// parameters, customized if possible. custom is true if the message is returned by
+// [ParseQueryOptions.MessageFunc], which describes the index of the slice element by itself.
+func (e *QueryValidationError) message(
+	fieldKey string,
+	index int,
+	code ErrorCode,
+	params map[string]any,
+	defaultMessage string,
+) (message string, custom bool) {
+	if localize := e.currentState().localize; localize != nil {
+		return localize(fieldKey, index, code, params, defaultMessage)
+	}
+
+	return defaultMessage, false
+}
+
+// indexedMessage prefixes the message with the index of the slice element it is reported for,
+// unless the index is [noIndex].
+func indexedMessage(index int, message string) string {
+	if index == noIndex {
+		return message
+	}
+
+	return "(Index: " + strconv.Itoa(index) + ") " + message
+}
+
+// err returns a copy of the validation error if it has any field or struct errors, or nil
+// otherwise. FieldErrors and StructErrors of the copy are non-nil. Validation errors used while
+// binding are created without them, so they are allocated only if there is an error. The state of
+// the copy is kept in returnedStates instead of the copy itself, so the copy is equal to the value
+// built from its exported fields.
+func (e *QueryValidationError) err() error {
+	if len(e.FieldErrors) == 0 && len(e.StructErrors) == 0 {
+		return nil
+	}
+
+	validationErr := &QueryValidationError{
+		Source:       e.Source,
+		FieldErrors:  e.FieldErrors,
+		StructErrors: e.StructErrors,
+	}
+
+	if validationErr.FieldErrors == nil {
+		validationErr.FieldErrors = make(map[string][]string)
+	}
+
+	if validationErr.StructErrors == nil {
+		validationErr.StructErrors = make([]string, 0)
+	}
+
+	key := reflect.ValueOf(validationErr).Pointer()
+	returnedStates.Store(key, e.currentState())
+	runtime.SetFinalizer(validationErr, func(*QueryValidationError) {
+		returnedStates.Delete(key)
+	})
+
+	return validationErr
+}
+
+// ParseQueryOptions is the options type for [ParseQuery] and the other parsers of the package.
+type ParseQueryOptions struct {
+	// ErrorOnNoBindableFields makes [ParseQuery] return [ErrNoBindableQueryFields] if the target
+	// struct has no fields bound to query parameters (e.g. all fields are tagged with
+	// `query:"-"`). It helps catching cases where a wrong struct type is passed.
+	ErrorOnNoBindableFields bool
+
+	// Atomic makes [ParseQuery] parse into a temporary struct and copy the bound fields into the
+	// target only if parsing succeeds. On failure the target is left untouched. Fields tagged with
+	// `query:"-"` are never modified.
+	Atomic bool
+
+	// PresenceBools makes bool values behave like flags: a query parameter with an empty value
+	// (e.g. `?no-cache` or `?no-cache=`) is casted as true, and an absent `bool` field is set to
+	// false instead of being reported as required. Explicit values such as `true` and `false` are
+	// still parsed as usual.
+	PresenceBools bool
+
+	// PostProcess is called with the target after all fields are bound without any validation
+	// errors. It can be used for computing derived fields, normalizing values, etc. The error
+	// returned from PostProcess is wrapped and returned from [ParseQuery].
+	PostProcess func(target any) error
+
+	// Validator validates the target struct after all fields are bound, e.g. a *validator.Validate
+	// of github.com/go-playground/validator, so the `validate` tags of its rules keep working. Its
+	// field errors are reported in [QueryValidationError.FieldErrors] with the keys of the fields,
+	// unless the fields already have errors, and its other errors in
+	// [QueryValidationError.StructErrors]. The tags of its field errors are used as their error
+	// codes, e.g. "email", with the "param" parameter.
+	Validator StructValidator
+
+	// StructValidators are called with the target after all fields are bound without validation
+	// errors, after the Validate method of the target if it implements [QueryStructValidator].
+	// The returned messages are reported as [QueryValidationError.StructErrors], e.g. for rules
+	// involving multiple fields.
+	StructValidators []func(target any) []string
+
+	// Presets are named lists of validation rules. Fields reference a preset by its name with the
+	// `preset` tag. Referencing an unknown preset causes [ErrUnknownPreset] error.
+	Presets map[string][]Rule
+
+	// FieldValidators are the validation rules of the fields keyed by their query names, e.g.
+	// "page_size" or "filter.status" for a field of a nested struct. They are applied like the
+	// rules of the presets, after them, so project specific rules can be added without changing
+	// the struct tags.
+	FieldValidators map[string][]Rule
+
+	// MapKeyStyle is the convention used for the query parameter names of map fields. Default is
+	// [MapKeyStyleBracket]. Other values than [MapKeyStyleBracket] and [MapKeyStyleDot] cause
+	// [ErrInvalidOption] error.
+	MapKeyStyle MapKeyStyle
+
+	// MultiValuePolicy is the behavior used when a field casted from a single value (e.g. an int
+	// field) has multiple values, e.g. `?page=1&page=2`. Default is [MultiValueFirst]. Fields can
+	// override it with the `multivalue` tag. Other values than [MultiValueFirst], [MultiValueLast]
+	// and [MultiValueError] cause [ErrInvalidOption] error.
+	MultiValuePolicy MultiValuePolicy
+
+	// BoolMode selects the values accepted by bool fields. Default is [BoolModeDefault]. Fields can
+	// override it with the `boolmode` tag. Other values than the defined modes cause
+	// [ErrInvalidOption] error.
+	BoolMode BoolMode
+
+	// DecimalSeparator is the decimal separator of the float values: "." or ",", e.g. "," for
+	// `?weight=70,5`. Default is ".". Fields can override it with the `decimalsep` tag, and accept
+	// thousand separators with the `groupsep` tag. Other values cause [ErrInvalidOption] error.
+	DecimalSeparator string
+
+	// RejectControlChars makes string values (including slice elements, pointed values and map
+	// values) containing control characters (e.g. null byte, tab, newline) or Unicode format
+	// characters (e.g. zero width space) invalid. Such values are reported with a
+	// "contains invalid characters" validation error before any other validation is applied.
+	RejectControlChars bool
+
+	// MultipartMaxMemory is the maximum number of bytes of the multipart form stored in memory by
+	// [ParseMultipart]; the rest of the files are stored on disk in temporary files. Default is
+	// 32 MB.
+	MultipartMaxMemory int64
+
+	// PathParams returns the path parameters of the request for [ParseRequest], e.g. mux.Vars. If
+	// it is nil, path parameters are read with [http.Request.PathValue] on Go 1.22 and later, and
+	// no path parameters are available on older versions.
+	PathParams func(r *http.Request) map[string]string
+
+	// BodyParsers are the parsers of additional media types for [ParseBody], keyed by the lower
+	// case media type without parameters, e.g. "application/yaml". They take precedence over the
+	// built-in parsers.
+	BodyParsers map[string]BodyParser
+
+	// TrustedProxies are the address prefixes of the proxies whose forwarding headers are trusted
+	// by [ParseForwarded], e.g. netip.MustParsePrefix("10.0.0.0/8").
+	TrustedProxies []netip.Prefix
+
+	// Converters are the converters of custom field types created with [NewConverter]. They take
+	// precedence over the converters registered with [RegisterConverter].
+	Converters []Converter
+
+	// MessageCatalog holds the templates of the localized validation error messages, which are
+	// used for the errors with a template in the language of Language. Struct errors other than
+	// the errors of the cross-field constraints are not localized.
+	MessageCatalog MessageCatalog
+
+	// Language is the language of the validation error messages in MessageCatalog, e.g. "tr". If
+	// it is empty, the parsers of requests, e.g. [ParseRequest] and [ParseBody], use the language
+	// matching the Accept-Language header of the request, see [MessageCatalog.Language], and the
+	// other parsers use the default English messages.
+	Language string
+
+	// MessageFunc returns the message of the validation error of the field with the given key,
+	// code and parameters, e.g. "page", [CodeMin] and {"min": 1}. The errors of slice elements
+	// have the additional "index" parameter, and the struct errors of the cross-field constraints
+	// have an empty key. The returned message is used as is; if it is empty, the message of
+	// MessageCatalog or the default message is used. Struct errors other than the errors of the
+	// cross-field constraints are not passed to it.
+	MessageFunc func(fieldKey string, code ErrorCode, params map[string]any) string
+
+	// ErrorResponse writes the response of the validation errors for [Middleware], [Handler] and
+	// [BindQuery], e.g. an "application/problem+json" response with
+	// [QueryValidationError.ToProblemDetails]. If it is nil, a 400 Bad Request response with the
+	// JSON encoding of the error is written.
+	ErrorResponse func(w http.ResponseWriter, r *http.Request, err *QueryValidationError)
+
+	// DetailedErrors makes the errors of the values that can't be casted to the types of their
+	// fields include the received values and the expected types, e.g. `must be a valid integer
+	// (got "abc", expected integer)`. They are also set in [FieldError] and passed to MessageFunc
+	// and the templates of MessageCatalog as the "value" and "expected" parameters. The received
+	// values are echoed back to the clients, so enable it only if that is acceptable.
+	DetailedErrors bool
+
+	// MaxErrorsPerField is the maximum number of the errors reported for a field, e.g. for the
+	// elements of a slice field. MaxTotalErrors is the maximum number of the field and struct
+	// errors reported in total. The further errors are dropped, which is reported by
+	// [QueryValidationError.Truncated]. They prevent large inputs, e.g. thousands of malformed
+	// slice elements, from producing large error responses. Zero or negative values mean no limit.
+	MaxErrorsPerField int
+	MaxTotalErrors    int
+}
 
 // ParseQuery parses query parameters into given struct.
 // If options are nil, default options are used.
+//
+// The fields of the struct type are analyzed on the first call and the result is cached, so the
+// later calls with the same struct type don't look up the struct tags again.
+//
+// Use [NewParser] to configure the options once and reuse them across calls.
 func ParseQuery(
 	queryParams map[string][]string,
 	target any,
 	opts *ParseQueryOptions,
 ) error {
-	if opts == nil {
-		opts = &ParseQueryOptions{} //nolint:ineffassign,wastedassign
-	}
+	return NewParser(opts).ParseQuery(queryParams, target)
+}
+
+// bindingSource describes a source of the values bound to struct fields, e.g. query parameters.
+type bindingSource struct {
+	// source is the source reported in the validation errors.
+	source Source
+
+	// tagName is the name of the struct tag that holds the key of the field in the source.
+	tagName string
+
+	// errTagNotFound is returned when a field doesn't have the struct tag.
+	errTagNotFound error
+
+	// description is used in the validation error messages, e.g. "query parameters".
+	description string
 
+	// allowFiles allows *multipart.FileHeader and []*multipart.FileHeader fields, which are bound
+	// from files.
+	allowFiles bool
+	files      map[string][]*multipart.FileHeader
+}
+
+var querySource = bindingSource{ //nolint:gochecknoglobals
+	source:         SourceQuery,
+	tagName:        "query",
+	errTagNotFound: ErrQueryTagNotFound,
+	description:    "query parameters",
+}
+
+// parseValues binds the values from the given source into the target struct. opts must be non-nil.
+func parseValues(
+	values map[string][]string,
+	target any,
+	source bindingSource,
+	opts *ParseQueryOptions,
+) error {
 	v := reflect.ValueOf(target)
 	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
 		return ErrInvalidQueryTarget
 	}
 
-	validationErrors := &QueryValidationError{
-		FieldErrors:  make(map[string][]string),
-		StructErrors: make([]string, 0),
+	plan, err := cachedStructPlan(v.Elem().Type(), source, opts)
+	if err != nil {
+		return err
+	}
+
+	return plan.bind(values, v, source, opts)
+}
+
+// bindField binds the values of the source into the struct field. fieldKey is the key of the field
+// in the source.
+func bindField(
+	fieldv reflect.Value,
+	structField reflect.StructField,
+	fieldKey string,
+	values map[string][]string,
+	source bindingSource,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) error {
+	if !isFieldTypeAllowedForSource(structField, source, opts.Converters) {
+		return fmt.Errorf(
+			"%w: %s (%s)",
+			ErrInvalidQueryFieldType,
+			structField.Name,
+			fieldv.Type(),
+		)
+	}
+
+	if source.allowFiles && isFileFieldType(fieldv.Type()) {
+		populateFileField(fieldv, structField, fieldKey, source.files, validationErrors)
+		return nil
+	}
+
+	return populateStructField(fieldv, structField, fieldKey, values, opts, validationErrors)
+}
+
+// isFieldTypeAllowedForSource reports whether fields of the given type can be bound from the
+// source.
+func isFieldTypeAllowedForSource(
+	structField reflect.StructField,
+	source bindingSource,
+	converters []Converter,
+) bool {
+	return isJSONEncodedField(structField) ||
+		(source.allowFiles && isFileFieldType(structField.Type)) ||
+		isFieldTypeAllowedForQueryParsing(structField.Type, converters)
+}
+
+// completeBinding is the common last step of binding values into the target struct. structElem is
+// the struct the values are bound into, which is a temporary struct if [ParseQueryOptions.Atomic]
+// is set. bindable reports whether the struct has fields bound from the source, and
+// boundFieldIndexes are the indexes of the fields set while binding. It checks the cross-field
+// rules, or runs [ParseQueryOptions.Validator] instead, whose errors are reported with the keys
+// returned by fieldKey. Then it runs the struct validators, reports the validation errors, copies
+// the bound fields into the target if needed and calls [ParseQueryOptions.PostProcess].
+func completeBinding(
+	target any,
+	structElem reflect.Value,
+	bindable bool,
+	boundFieldIndexes []int,
+	validationErrors *QueryValidationError,
+	opts *ParseQueryOptions,
+	fieldKey fieldKeyFunc,
+) error {
+	if opts.ErrorOnNoBindableFields && !bindable {
+		return ErrNoBindableQueryFields
+	}
+
+	if opts.Validator != nil {
+		runValidator(structElem, fieldKey, validationErrors, opts.Validator)
+	} else if err := runCrossFieldRules(structElem, fieldKey, validationErrors); err != nil {
+		return err
 	}
 
-	structElem := v.Elem()
+	validationErrors.sortFieldOrder(structElem.Type(), fieldKey)
+
+	if err := validationErrors.err(); err != nil {
+		return err
+	}
+
+	// Struct validators see the values of all fields, so they run only if the fields are valid.
+	runStructValidators(structElem, validationErrors, opts.StructValidators)
+
+	if err := validationErrors.err(); err != nil {
+		return err
+	}
 
-	for i := 0; i < structElem.NumField(); i++ {
-		fieldv := structElem.Field(i)
-		structField := structElem.Type().Field(i)
+	if opts.Atomic {
+		copyStructFields(reflect.ValueOf(target).Elem(), structElem, boundFieldIndexes)
+	}
 
-		if !isFieldTypeAllowedForQueryParsing(fieldv.Type()) {
-			return fmt.Errorf(
-				"%w: %s (%s)",
-				ErrInvalidQueryFieldType,
-				structField.Name,
-				fieldv.Type(),
-			)
+	if opts.PostProcess != nil {
+		if err := opts.PostProcess(target); err != nil {
+			return fmt.Errorf("post processing parsed query failed: %w", err)
 		}
+	}
+
+	return nil
+}
+
+// copyStructFields copies the fields at the given indexes from src struct to dst struct. Slice and
+// pointer fields are copied into newly allocated values so dst doesn't share memory with src.
+func copyStructFields(dst, src reflect.Value, fieldIndexes []int) {
+	for _, i := range fieldIndexes {
+		srcField := src.Field(i)
 
-		if err := populateStructFieldFromQuery(fieldv, structField, queryParams, validationErrors); err != nil {
-			return err
+		switch {
+		case !dst.Field(i).CanSet():
+			// Embedded structs of unexported types can't be set, but their exported fields can.
+			copyStructFields(dst.Field(i), srcField, settableFieldIndexes(srcField.Type()))
+		case srcField.Kind() == reflect.Slice && !srcField.IsNil():
+			newSlice := reflect.MakeSlice(srcField.Type(), srcField.Len(), srcField.Len())
+			reflect.Copy(newSlice, srcField)
+			dst.Field(i).Set(newSlice)
+		case srcField.Kind() == reflect.Pointer && !srcField.IsNil():
+			newPointer := reflect.New(srcField.Type().Elem())
+			newPointer.Elem().Set(srcField.Elem())
+			dst.Field(i).Set(newPointer)
+		default:
+			dst.Field(i).Set(srcField)
 		}
 	}
+}
+
+// settableFieldIndexes returns the indexes of the exported fields and the embedded struct fields
+// of the struct type.
+func settableFieldIndexes(structType reflect.Type) []int {
+	indexes := make([]int, 0, structType.NumField())
 
-	if len(validationErrors.StructErrors) > 0 || len(validationErrors.FieldErrors) > 0 {
-		return validationErrors
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if structField.IsExported() ||
+			(structField.Anonymous && structField.Type.Kind() == reflect.Struct) {
+			indexes = append(indexes, i)
+		}
 	}
 
-	return nil
+	return indexes
 }
 
-func isFieldTypeAllowedForQueryParsing(fieldType reflect.Type) bool {
-	switch fieldType.Kind() { //nolint:exhaustive
-	case reflect.String, reflect.Int, reflect.Float64, reflect.Bool:
-		return true
+func isFieldTypeAllowedForQueryParsing(fieldType reflect.Type, converters []Converter) bool {
+	switch bindingKind(fieldType, converters) { //nolint:exhaustive
 	case reflect.Slice:
-		switch fieldType.Elem().Kind() { //nolint:exhaustive
-		case reflect.String, reflect.Int, reflect.Float64, reflect.Bool:
-			return true
-		default:
-			return false
+		if isPointerElementType(fieldType.Elem(), converters) {
+			return isValueTypeAllowedForQueryParsing(fieldType.Elem().Elem(), converters)
 		}
+
+		return isValueTypeAllowedForQueryParsing(fieldType.Elem(), converters)
 	case reflect.Pointer:
-		switch fieldType.Elem().Kind() { //nolint:exhaustive
-		case reflect.String, reflect.Int, reflect.Float64, reflect.Bool:
-			return true
-		default:
-			return false
+		if isPointerToSliceType(fieldType, converters) {
+			return isFieldTypeAllowedForQueryParsing(fieldType.Elem(), converters)
+		}
+
+		return isValueTypeAllowedForQueryParsing(fieldType.Elem(), converters)
+	case reflect.Map:
+		return fieldType.Key().Kind() == reflect.String &&
+			isValueTypeAllowedForQueryParsing(fieldType.Elem(), converters)
+	default:
+		return isValueTypeAllowedForQueryParsing(fieldType, converters)
+	}
+}
+
+// bindingKind returns the kind of the field type used for binding: [reflect.Slice],
+// [reflect.Pointer] or [reflect.Map] for the slice, pointer and map fields, and [reflect.Invalid]
+// for the fields casted from a single value. Types with a converter or implementing
+// [encoding.TextUnmarshaler] are casted from a single value even if they are slices, e.g. [net.IP].
+func bindingKind(fieldType reflect.Type, converters []Converter) reflect.Kind {
+	switch fieldType.Kind() { //nolint:exhaustive
+	case reflect.Slice, reflect.Pointer, reflect.Map:
+		if _, ok := lookupConverter(fieldType, converters); ok || isTextUnmarshalerType(fieldType) {
+			return reflect.Invalid
+		}
+
+		if fieldType == rawMessageType {
+			return reflect.Invalid
 		}
+
+		return fieldType.Kind()
+	default:
+		return reflect.Invalid
+	}
+}
+
+// isValueTypeAllowedForQueryParsing reports whether a single query value can be casted to the
+// given type. Slice and pointer fields are allowed if their element type is allowed.
+func isValueTypeAllowedForQueryParsing(valueType reflect.Type, converters []Converter) bool {
+	if _, ok := lookupConverter(valueType, converters); ok {
+		return true
+	}
+
+	switch valueType {
+	case timeType, durationType, rangeHeaderType, acceptLanguageType, urlType, rawMessageType:
+		return true
+	}
+
+	if isSQLNullType(valueType) {
+		return isValueTypeAllowedForQueryParsing(valueType.Field(0).Type, converters)
+	}
+
+	if isTextUnmarshalerType(valueType) {
+		return true
+	}
+
+	switch valueType.Kind() { //nolint:exhaustive
+	case reflect.String, reflect.Bool, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
 	default:
 		return false
 	}
 }
 
-// populateStructFieldFromQuery finds the associated query param for the struct field and sets the
-// field value accordingly. It handles default values, required fields, type casting and validation
-// errors.
-func populateStructFieldFromQuery( //nolint:cyclop,funlen
+// populateStructField finds the associated values for the struct field and sets the field value
+// accordingly. It handles default values, required fields, type casting and validation errors.
+func populateStructField(
 	fieldv reflect.Value,
 	structField reflect.StructField,
-	queryParams map[string][]string,
+	fieldKey string,
+	sourceValues map[string][]string,
+	opts *ParseQueryOptions,
 	validationErrors *QueryValidationError,
 ) error {
-	fieldQueryKey, ok := structField.Tag.Lookup("query")
-	if !ok {
-		return fmt.Errorf("%w: %s", ErrQueryTagNotFound, structField.Name)
+	plan, err := newFieldPlan(structField, fieldKey, opts)
+	if err != nil {
+		return err
 	}
 
-	values, ok := queryParams[fieldQueryKey]
-	if !ok {
-		fieldDefaultValue, ok := structField.Tag.Lookup("default")
-		if !ok {
-			switch fieldv.Kind() { //nolint:exhaustive
-			case reflect.Slice:
-				// If default value is not specified for slice field which is not present in the
-				// query params, set an empty slice.
-				fieldv.Set(reflect.MakeSlice(fieldv.Type(), 0, 0))
-			case reflect.Pointer:
-				// If default value is not specified for pointer field which is not present in the
-				// query params, set nil.
-				fieldv.Set(reflect.Zero(fieldv.Type()))
-			default:
-				// If default value is not specified for other type of field which is not present in
-				// the query params, add a validation error to indicate that the field is required.
-				validationErrors.FieldErrors[fieldQueryKey] = append(
-					validationErrors.FieldErrors[fieldQueryKey], "field is required",
-				)
-			}
+	return plan.populate(fieldv, sourceValues, opts, validationErrors)
+}
 
-			return nil
-		}
+// isNumericField reports whether the field is a numeric field or a slice/pointer of numeric
+// elements.
+func isNumericField(fieldType reflect.Type, converters []Converter) bool {
+	fieldType = fieldValueType(fieldType, converters)
 
-		if fieldv.Kind() == reflect.Slice {
-			values = strings.Split(fieldDefaultValue, ",")
-		} else {
-			values = []string{fieldDefaultValue}
-		}
+	if _, ok := lookupConverter(fieldType, converters); ok {
+		return false
 	}
 
-	// Set the field value by the query values
-	structFieldKind := fieldv.Kind()
-	switch structFieldKind { //nolint:exhaustive
-	case reflect.Slice:
-		setSliceFieldValue(fieldv, values, fieldQueryKey, validationErrors)
+	if fieldType == bigIntType || fieldType == bigFloatType {
+		return true
+	}
 
-	case reflect.Pointer:
-		setPointerFieldValue(fieldv, values, fieldQueryKey, validationErrors)
+	if isTextUnmarshalerType(fieldType) {
+		return false
+	}
 
-	case reflect.String:
-		fieldv.SetString(values[0])
+	switch fieldType.Kind() { //nolint:exhaustive
+	case reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
 
-	case reflect.Int:
-		i, err := strconv.Atoi(values[0])
-		if err != nil {
-			validationErrors.FieldErrors[fieldQueryKey] = append(
-				validationErrors.FieldErrors[fieldQueryKey], "must be a valid integer",
-			)
-			break
-		}
+// fieldValueType returns the type of the values casted for the field type: the element type of
+// slices and pointers (including pointers to slices and slices of pointers), and the inner type of
+// the nullable types of database/sql. Other types are returned as is.
+func fieldValueType(fieldType reflect.Type, converters []Converter) reflect.Type {
+	if isPointerToSliceType(fieldType, converters) {
+		fieldType = fieldType.Elem()
+	}
+
+	kind := bindingKind(fieldType, converters)
+	if kind == reflect.Slice || kind == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
 
-		fieldv.SetInt(int64(i))
+	if kind == reflect.Slice && isPointerElementType(fieldType, converters) {
+		fieldType = fieldType.Elem()
+	}
 
-	case reflect.Float64:
-		f, err := strconv.ParseFloat(values[0], 64)
-		if err != nil {
-			validationErrors.FieldErrors[fieldQueryKey] = append(
-				validationErrors.FieldErrors[fieldQueryKey], "must be a valid float",
-			)
-			break
-		}
+	if _, ok := lookupConverter(fieldType, converters); !ok && isSQLNullType(fieldType) {
+		fieldType = fieldType.Field(0).Type
+	}
 
-		fieldv.SetFloat(f)
+	return fieldType
+}
 
-	case reflect.Bool:
-		b, err := strconv.ParseBool(values[0])
-		if err != nil {
-			validationErrors.FieldErrors[fieldQueryKey] = append(
-				validationErrors.FieldErrors[fieldQueryKey], "must be a valid boolean",
-			)
-			break
-		}
+// stripValueSuffixes returns a copy of values where the first matching suffix is removed from
+// each value. Values that don't end with any of the suffixes are returned as is.
+func stripValueSuffixes(values []string, suffixes []string) []string {
+	stripped := make([]string, len(values))
 
-		fieldv.SetBool(b)
+	for i, v := range values {
+		stripped[i] = v
+
+		for _, suffix := range suffixes {
+			if suffix != "" && strings.HasSuffix(v, suffix) {
+				stripped[i] = strings.TrimSuffix(v, suffix)
+				break
+			}
+		}
 	}
 
-	return nil
+	return stripped
 }
 
-func setSliceFieldValue( //nolint:cyclop
+// setSliceFieldValue casts the values and sets them to the slice field. It returns false if any of
+// the values can't be casted.
+func setSliceFieldValue(
 	fieldv reflect.Value,
 	values []string,
-	fieldQueryKey string,
+	castOpts castOptions,
+	fieldKey string,
 	validationErrors *QueryValidationError,
-) {
-	sliceElementKind := fieldv.Type().Elem().Kind()
-
-	switch sliceElementKind { //nolint:exhaustive
-	case reflect.String:
-		fieldv.Set(reflect.ValueOf(values))
-
-	case reflect.Int:
-		newSlice := make([]int, len(values))
-		for i, v := range values {
-			intValue, err := strconv.Atoi(v)
-			if err != nil {
-				validationErrors.FieldErrors[fieldQueryKey] = append(
-					validationErrors.FieldErrors[fieldQueryKey],
-					"(Index: "+strconv.Itoa(i)+") must be a valid integer",
-				)
-			}
+) bool {
+	sliceElementType := fieldv.Type().Elem()
+	pointerElements := isPointerElementType(sliceElementType, castOpts.converters)
 
-			newSlice[i] = intValue
+	castedValues, errs := CastSlice(values, func(value string) (reflect.Value, error) {
+		if !pointerElements {
+			return castQueryValue(sliceElementType, value, castOpts)
 		}
 
-		fieldv.Set(reflect.ValueOf(newSlice))
-
-	case reflect.Float64:
-		newSlice := make([]float64, len(values))
-		for i, v := range values {
-			floatValue, err := strconv.ParseFloat(v, 64)
-			if err != nil {
-				validationErrors.FieldErrors[fieldQueryKey] = append(
-					validationErrors.FieldErrors[fieldQueryKey],
-					"(Index: "+strconv.Itoa(i)+") must be a valid float",
-				)
-			}
+		// Empty values of the slices of pointers are nil elements.
+		if value == "" {
+			return reflect.Zero(sliceElementType), nil
+		}
+
+		castedValue, err := castQueryValue(sliceElementType.Elem(), value, castOpts)
+		if err != nil {
+			return castedValue, err
+		}
+
+		pointer := reflect.New(sliceElementType.Elem())
+		pointer.Elem().Set(castedValue)
+
+		return pointer, nil
+	})
+	newSlice := reflect.MakeSlice(fieldv.Type(), len(values), len(values))
 
-			newSlice[i] = floatValue
+	for i, castedValue := range castedValues {
+		if errs != nil && errs[i] != nil {
+			validationErrors.addFieldErr(fieldKey, i, errs[i])
+			continue
 		}
 
-		fieldv.Set(reflect.ValueOf(newSlice))
-
-	case reflect.Bool:
-		newSlice := make([]bool, len(values))
-		for i, v := range values {
-			boolValue, err := strconv.ParseBool(v)
-			if err != nil {
-				validationErrors.FieldErrors[fieldQueryKey] = append(
-					validationErrors.FieldErrors[fieldQueryKey],
-					"(Index: "+strconv.Itoa(i)+") must be a valid boolean",
-				)
+		newSlice.Index(i).Set(castedValue)
+	}
+
+	fieldv.Set(newSlice)
+
+	return errs == nil
+}
+
+// isPointerToSliceType reports whether the type is a pointer to a slice type bound from multiple
+// values, e.g. *[]string. Unlike slice fields, these fields are nil if they are not present.
+func isPointerToSliceType(fieldType reflect.Type, converters []Converter) bool {
+	return bindingKind(fieldType, converters) == reflect.Pointer &&
+		bindingKind(fieldType.Elem(), converters) == reflect.Slice
+}
+
+// isPointerElementType reports whether the slice element type is a pointer type whose elements
+// are casted into newly allocated values, e.g. the element type of []*int. Pointer types with
+// converters are casted with the converters instead.
+func isPointerElementType(elementType reflect.Type, converters []Converter) bool {
+	if elementType.Kind() != reflect.Pointer {
+		return false
+	}
+
+	_, ok := lookupConverter(elementType, converters)
+
+	return !ok
+}
+
+// hasDuplicateElements reports whether the slice has any equal elements. Pointer elements and
+// elements of non-comparable types, e.g. [net.IP], are compared with [reflect.DeepEqual].
+func hasDuplicateElements(slice reflect.Value) bool {
+	// Pointer elements are compared by the values they point to.
+	elementType := slice.Type().Elem()
+	if !elementType.Comparable() || elementType.Kind() == reflect.Pointer {
+		for i := 0; i < slice.Len(); i++ {
+			for j := 0; j < i; j++ {
+				if reflect.DeepEqual(slice.Index(i).Interface(), slice.Index(j).Interface()) {
+					return true
+				}
 			}
+		}
+
+		return false
+	}
+
+	seen := make(map[any]struct{}, slice.Len())
 
-			newSlice[i] = boolValue
+	for i := 0; i < slice.Len(); i++ {
+		element := slice.Index(i).Interface()
+		if _, ok := seen[element]; ok {
+			return true
 		}
 
-		fieldv.Set(reflect.ValueOf(newSlice))
+		seen[element] = struct{}{}
 	}
+
+	return false
 }
 
+// setPointerFieldValue casts the first value and sets the pointer field to point to it. It returns
+// false if the value can't be casted.
 func setPointerFieldValue(
 	fieldv reflect.Value,
 	values []string,
-	fieldQueryKey string,
+	castOpts castOptions,
+	fieldKey string,
 	validationErrors *QueryValidationError,
-) {
-	pointerElementKind := fieldv.Type().Elem().Kind()
+) bool {
+	pointerElementType := fieldv.Type().Elem()
 
-	switch pointerElementKind { //nolint:exhaustive
-	case reflect.String:
-		fieldv.Set(reflect.New(fieldv.Type().Elem()))
-		fieldv.Elem().SetString(values[0])
-
-	case reflect.Int:
-		i, err := strconv.Atoi(values[0])
-		if err != nil {
-			validationErrors.FieldErrors[fieldQueryKey] = append(
-				validationErrors.FieldErrors[fieldQueryKey], "must be a valid integer",
-			)
-			return
+	if isPointerToSliceType(fieldv.Type(), castOpts.converters) {
+		// A single empty value means an empty slice, e.g. `?tags=`.
+		if len(values) == 1 && values[0] == "" {
+			values = []string{}
 		}
 
-		fieldv.Set(reflect.New(fieldv.Type().Elem()))
-		fieldv.Elem().SetInt(int64(i))
+		slicev := reflect.New(pointerElementType)
+		casted := setSliceFieldValue(slicev.Elem(), values, castOpts, fieldKey, validationErrors)
+		fieldv.Set(slicev)
 
-	case reflect.Float64:
-		f, err := strconv.ParseFloat(values[0], 64)
-		if err != nil {
-			validationErrors.FieldErrors[fieldQueryKey] = append(
-				validationErrors.FieldErrors[fieldQueryKey], "must be a valid float",
-			)
-			return
-		}
+		return casted
+	}
 
-		fieldv.Set(reflect.New(fieldv.Type().Elem()))
-		fieldv.Elem().SetFloat(f)
+	castedValue, err := castQueryValue(pointerElementType, values[0], castOpts)
+	if err != nil {
+		validationErrors.addFieldErr(fieldKey, noIndex, err)
+		return false
+	}
 
-	case reflect.Bool:
-		b, err := strconv.ParseBool(values[0])
-		if err != nil {
-			validationErrors.FieldErrors[fieldQueryKey] = append(
-				validationErrors.FieldErrors[fieldQueryKey], "must be a valid boolean",
-			)
-			return
-		}
+	fieldv.Set(reflect.New(pointerElementType))
+	fieldv.Elem().Set(castedValue)
 
-		fieldv.Set(reflect.New(fieldv.Type().Elem()))
-		fieldv.Elem().SetBool(b)
-	}
+	return true
 }
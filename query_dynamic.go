@@ -0,0 +1,114 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldSpec describes a single query parameter for [ParseQueryDynamic].
+type FieldSpec struct {
+	// QueryKey is the name of the query parameter.
+	QueryKey string
+
+	// Kind is the kind of the parsed value. Only [reflect.String], [reflect.Int],
+	// [reflect.Float64] and [reflect.Bool] are supported.
+	Kind reflect.Kind
+
+	// Required indicates whether the query parameter must be present. It is ignored if Default is
+	// not empty.
+	Required bool
+
+	// Default is the value used when the query parameter is not present. Empty string means no
+	// default value.
+	Default string
+}
+
+// dynamicKindTypes maps the kinds supported by [FieldSpec] to the types used for casting.
+var dynamicKindTypes = map[reflect.Kind]reflect.Type{ //nolint:gochecknoglobals
+	reflect.String:  reflect.TypeOf(""),
+	reflect.Int:     reflect.TypeOf(0),
+	reflect.Float64: reflect.TypeOf(0.0),
+	reflect.Bool:    reflect.TypeOf(false),
+}
+
+// ParseQueryDynamic parses query parameters according to the given schema instead of a struct. It
+// is meant for cases where the query parameters are not known at compile time.
+//
+// Parsed values are returned in a map keyed by the query name of the fields. Optional fields that
+// are not present in the query parameters are not included in the map. Values are casted the same
+// way as [ParseQuery] does and the validation errors are reported with [QueryValidationError] type
+// in the same format. The options affecting the casting, e.g. BoolMode, DecimalSeparator,
+// PresenceBools and RejectControlChars, are applied too. If there are validation errors, the
+// returned map is nil.
+//
+// A field spec with an unsupported Kind causes [ErrInvalidQueryFieldType] error, and invalid
+// options cause [ErrInvalidOption] error. If options are nil, default options are used.
+func ParseQueryDynamic(
+	queryParams map[string][]string,
+	schema []FieldSpec,
+	opts *ParseQueryOptions,
+) (map[string]any, error) {
+	return NewParser(opts).ParseQueryDynamic(queryParams, schema)
+}
+
+// parseQueryDynamic is the implementation of [Parser.ParseQueryDynamic]. opts must be non-nil.
+func parseQueryDynamic(
+	queryParams map[string][]string,
+	schema []FieldSpec,
+	opts *ParseQueryOptions,
+) (map[string]any, error) {
+	if err := checkOptions(opts); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]any, len(schema))
+	validationErrors := newQueryValidationError(SourceQuery, querySource.description, opts)
+
+	for _, spec := range schema {
+		targetType, ok := dynamicKindTypes[spec.Kind]
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: %s (%s)",
+				ErrInvalidQueryFieldType,
+				spec.QueryKey,
+				spec.Kind,
+			)
+		}
+
+		values, ok := queryParams[spec.QueryKey]
+		if !ok {
+			switch {
+			case spec.Default != "":
+				values = []string{spec.Default}
+			case spec.Required:
+				validationErrors.addFieldErr(spec.QueryKey, noIndex, errRequired)
+				continue
+			default:
+				continue
+			}
+		}
+
+		// Values are casted with the options the same way as the values of the struct fields
+		// without struct tags.
+		castOpts, err := newCastOptions(
+			reflect.StructField{Name: spec.QueryKey, Type: targetType}, opts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		castedValue, err := castQueryValue(targetType, values[0], castOpts)
+		if err != nil {
+			validationErrors.addFieldErr(spec.QueryKey, noIndex, err)
+			continue
+		}
+
+		result[spec.QueryKey] = castedValue.Interface()
+	}
+
+	if err := validationErrors.err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
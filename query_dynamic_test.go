@@ -0,0 +1,153 @@
+package reqparse_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryDynamic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"name":      {"John", "Doe"},
+			"age":       {"30"},
+			"is_active": {"true"},
+			"weight":    {"70.5"},
+		}
+
+		schema := []reqparse.FieldSpec{
+			{QueryKey: "name", Kind: reflect.String, Required: true},
+			{QueryKey: "age", Kind: reflect.Int, Required: true},
+			{QueryKey: "is_active", Kind: reflect.Bool},
+			{QueryKey: "weight", Kind: reflect.Float64},
+			{QueryKey: "page", Kind: reflect.Int, Default: "1"},
+			{QueryKey: "location", Kind: reflect.String},
+		}
+
+		result, err := reqparse.ParseQueryDynamic(inputQueryParams, schema, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"name":      "John",
+			"age":       30,
+			"is_active": true,
+			"weight":    70.5,
+			"page":      1,
+		}, result)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"age":       {"thirty"},
+			"is_active": {"yes please"},
+		}
+
+		schema := []reqparse.FieldSpec{
+			{QueryKey: "name", Kind: reflect.String, Required: true},
+			{QueryKey: "age", Kind: reflect.Int},
+			{QueryKey: "is_active", Kind: reflect.Bool},
+			{QueryKey: "weight", Kind: reflect.Float64, Default: "heavy"},
+		}
+
+		result, err := reqparse.ParseQueryDynamic(inputQueryParams, schema, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Nil(t, result)
+		assert.Equal(t, map[string][]string{
+			"name":      {"field is required"},
+			"age":       {"must be a valid integer"},
+			"is_active": {"must be a valid boolean"},
+			"weight":    {"must be a valid float"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, []string{}, validationError.StructErrors)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		schema := []reqparse.FieldSpec{
+			{QueryKey: "name", Kind: reflect.String, Required: true},
+			{QueryKey: "age", Kind: reflect.Int, Required: true},
+		}
+
+		parser := reqparse.NewParser(&reqparse.ParseQueryOptions{
+			MaxTotalErrors: 1,
+			MessageFunc: func(fieldKey string, code reqparse.ErrorCode, _ map[string]any) string {
+				return fieldKey + ": " + string(code)
+			},
+		})
+
+		_, err := parser.ParseQueryDynamic(map[string][]string{}, schema)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name": {"name: required"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("cast options", func(t *testing.T) {
+		t.Parallel()
+
+		schema := []reqparse.FieldSpec{
+			{QueryKey: "price", Kind: reflect.Float64},
+			{QueryKey: "active", Kind: reflect.Bool},
+			{QueryKey: "verbose", Kind: reflect.Bool},
+			{QueryKey: "name", Kind: reflect.String},
+		}
+
+		parser := reqparse.NewParser(&reqparse.ParseQueryOptions{
+			DecimalSeparator:   ",",
+			BoolMode:           reqparse.BoolModeLenient,
+			PresenceBools:      true,
+			RejectControlChars: true,
+		})
+
+		result, err := parser.ParseQueryDynamic(map[string][]string{
+			"price":   {"70,5"},
+			"active":  {"yes"},
+			"verbose": {""},
+			"name":    {"Berk"},
+		}, schema)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"price": 70.5, "active": true, "verbose": true, "name": "Berk",
+		}, result)
+
+		_, err = parser.ParseQueryDynamic(map[string][]string{"name": {"a\x00b"}}, schema)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Contains(t, validationError.FieldErrors, "name")
+
+		_, err = reqparse.ParseQueryDynamic(
+			map[string][]string{}, schema, &reqparse.ParseQueryOptions{BoolMode: "unknown"},
+		)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidOption)
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		t.Parallel()
+
+		schema := []reqparse.FieldSpec{
+			{QueryKey: "ids", Kind: reflect.Slice},
+		}
+
+		result, err := reqparse.ParseQueryDynamic(map[string][]string{}, schema, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+		assert.Nil(t, result)
+	})
+}
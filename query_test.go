@@ -1,8 +1,11 @@
 package reqparse_test
 
 import (
+	"encoding/json"
+	"errors"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/berk-karaal/reqparse"
 	"github.com/stretchr/testify/assert"
@@ -33,15 +36,15 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 		}
 
 		type MyStruct struct {
-			Name string `query:"name"`
-			Age  uint   `query:"age"`
+			Name string     `query:"name"`
+			Age  complex128 `query:"age"`
 		}
 
 		var s MyStruct
 		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
 
 		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
-		require.EqualError(t, err, "field type is not allowed for query parsing: Age (uint)")
+		require.EqualError(t, err, "field type is not allowed for query parsing: Age (complex128)")
 	})
 
 	t.Run("invalid slice target field type", func(t *testing.T) {
@@ -53,15 +56,17 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 		}
 
 		type MyStruct struct {
-			Param1 []string `query:"param1"`
-			Param2 []uint   `query:"param2"`
+			Param1 []string     `query:"param1"`
+			Param2 []complex128 `query:"param2"`
 		}
 
 		var s MyStruct
 		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
 
 		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
-		require.EqualError(t, err, "field type is not allowed for query parsing: Param2 ([]uint)")
+		require.EqualError(
+			t, err, "field type is not allowed for query parsing: Param2 ([]complex128)",
+		)
 	})
 
 	t.Run("invalid pointer target field type", func(t *testing.T) {
@@ -70,15 +75,15 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 		inputQueryParams := map[string][]string{}
 
 		type MyStruct struct {
-			Name *string `query:"name"`
-			Age  *uint   `query:"age"`
+			Name *string     `query:"name"`
+			Age  *complex128 `query:"age"`
 		}
 
 		var s MyStruct
 		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
 
 		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
-		require.EqualError(t, err, "field type is not allowed for query parsing: Age (*uint)")
+		require.EqualError(t, err, "field type is not allowed for query parsing: Age (*complex128)")
 	})
 
 	t.Run("query params happy path", func(t *testing.T) {
@@ -154,6 +159,7 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 		var validationError *reqparse.QueryValidationError
 		require.ErrorAs(t, err, &validationError)
 		assert.Equal(t, reqparse.QueryValidationError{
+			Source: reqparse.SourceQuery,
 			FieldErrors: map[string][]string{
 				"name": {
 					"field is required",
@@ -193,6 +199,7 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 		var validationError *reqparse.QueryValidationError
 		require.ErrorAs(t, err, &validationError)
 		assert.Equal(t, reqparse.QueryValidationError{
+			Source: reqparse.SourceQuery,
 			FieldErrors: map[string][]string{
 				"age": {
 					"must be a valid integer",
@@ -367,6 +374,7 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 		var validationError *reqparse.QueryValidationError
 		require.ErrorAs(t, err, &validationError)
 		assert.Equal(t, reqparse.QueryValidationError{
+			Source: reqparse.SourceQuery,
 			FieldErrors: map[string][]string{
 				"param1": {
 					"(Index: 0) must be a valid integer",
@@ -459,6 +467,7 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 		var validationError *reqparse.QueryValidationError
 		require.ErrorAs(t, err, &validationError)
 		assert.Equal(t, reqparse.QueryValidationError{
+			Source: reqparse.SourceQuery,
 			FieldErrors: map[string][]string{
 				"param1": {
 					"must be a valid integer",
@@ -503,4 +512,1345 @@ func TestParseQuery(t *testing.T) { //nolint:funlen,maintidx
 			)
 		}
 	})
+
+	t.Run("strip suffix", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"zoom":    {"150%"},
+			"size":    {"10px"},
+			"width":   {"10.5em"},
+			"margins": {"1px", "2", "3em"},
+			"ratio":   {"50%"},
+			"name":    {"100%"},
+		}
+
+		type MyStruct struct {
+			Zoom    int      `query:"zoom"    stripsuffix:"%"`
+			Size    int      `query:"size"    stripsuffix:"px,em"`
+			Width   *float64 `query:"width"   stripsuffix:"px,em"`
+			Margins []int    `query:"margins" stripsuffix:"px,em"`
+			Ratio   int      `query:"ratio"   stripsuffix:"%"     default:"100%"`
+			Offset  int      `query:"offset"  stripsuffix:"px"    default:"5px"`
+			Name    string   `query:"name"    stripsuffix:"%"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Zoom:    150,
+			Size:    10,
+			Width:   newPointer(10.5),
+			Margins: []int{1, 2, 3},
+			Ratio:   50,
+			Offset:  5,
+			Name:    "100%",
+		}, s)
+		assert.Equal(t, []string{"1px", "2", "3em"}, inputQueryParams["margins"])
+	})
+
+	t.Run("strip suffix without matching suffix", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"zoom":  {"150"},
+			"size":  {"10pt"},
+			"sizes": {"10px", "20pt"},
+		}
+
+		type MyStruct struct {
+			Zoom  int   `query:"zoom"  stripsuffix:"%"`
+			Size  *int  `query:"size"  stripsuffix:"px"`
+			Sizes []int `query:"sizes" stripsuffix:"px"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, 150, s.Zoom)
+		assert.Equal(t, map[string][]string{
+			"size":  {"must be a valid integer"},
+			"sizes": {"(Index: 1) must be a valid integer"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("ignored field", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"name":    {"John"},
+			"user_id": {"42"},
+			"-":       {"value"},
+		}
+
+		type MyStruct struct {
+			Name    string         `query:"name"`
+			UserID  int            `query:"-"`
+			Session map[string]int `query:"-"`
+		}
+
+		s := MyStruct{UserID: 7}
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Name:    "John",
+			UserID:  7,
+			Session: nil,
+		}, s)
+	})
+
+	t.Run("required tag", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"names": {},
+			"tags":  {"a", "b"},
+		}
+
+		type MyStruct struct {
+			IDs      []int    `query:"ids"      required:"true"`
+			Names    []string `query:"names"    required:"true"`
+			Tags     []string `query:"tags"     required:"true"`
+			Page     *int     `query:"page"     required:"true"`
+			Roles    []string `query:"roles"    required:"true" default:"user"`
+			Optional []string `query:"optional" required:"false"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"ids":  {"field is required"},
+			"page": {"field is required"},
+		}, validationError.FieldErrors)
+		assert.Nil(t, s.IDs)
+		// A present key without values is not reported as absent.
+		assert.Equal(t, []string{}, s.Names)
+		assert.Equal(t, []string{"a", "b"}, s.Tags)
+		assert.Equal(t, []string{"user"}, s.Roles)
+		assert.Equal(t, []string{}, s.Optional)
+	})
+
+	t.Run("error on no bindable fields", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"name": {"John"},
+		}
+
+		type NoBindableFields struct {
+			Name string `query:"-"`
+		}
+
+		type MyStruct struct {
+			Name string `query:"name"`
+		}
+
+		opts := &reqparse.ParseQueryOptions{ErrorOnNoBindableFields: true}
+
+		var s1 NoBindableFields
+		err := reqparse.ParseQuery(inputQueryParams, &s1, opts)
+		require.ErrorIs(t, err, reqparse.ErrNoBindableQueryFields)
+
+		var s2 struct{}
+		err = reqparse.ParseQuery(inputQueryParams, &s2, opts)
+		require.ErrorIs(t, err, reqparse.ErrNoBindableQueryFields)
+
+		var s3 NoBindableFields
+		err = reqparse.ParseQuery(inputQueryParams, &s3, nil)
+		require.NoError(t, err)
+
+		var s4 MyStruct
+		err = reqparse.ParseQuery(inputQueryParams, &s4, opts)
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Name: "John"}, s4)
+	})
+
+	t.Run("time params", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"created_at": {"2024-05-01T10:20:30Z"},
+			"from":       {"2024/05/01"},
+			"to":         {"02.05.2024"},
+			"days":       {"2024-05-01", "2024/05/02", "03.05.2024"},
+		}
+
+		type MyStruct struct {
+			CreatedAt time.Time   `query:"created_at"`
+			From      time.Time   `query:"from"       layout:"2006-01-02|2006/01/02|02.01.2006"`
+			To        *time.Time  `query:"to"         layout:"2006-01-02|2006/01/02|02.01.2006"`
+			Days      []time.Time `query:"days"       layout:"2006-01-02|2006/01/02|02.01.2006"`
+			Until     *time.Time  `query:"until"      layout:"2006-01-02"`
+			Since     time.Time   `query:"since"      layout:"2006-01-02"                       default:"2024-01-01"` //nolint:lll
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			CreatedAt: time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC),
+			From:      time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			To:        newPointer(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)),
+			Days: []time.Time{
+				time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC),
+			},
+			Until: nil,
+			Since: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}, s)
+	})
+
+	t.Run("time params type casting validation error", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"created_at": {"2024-05-01"},
+			"from":       {"01-05-2024"},
+			"to":         {"yesterday"},
+			"days":       {"2024-05-01", "2024.05.02"},
+		}
+
+		type MyStruct struct {
+			CreatedAt time.Time   `query:"created_at"`
+			From      time.Time   `query:"from"       layout:"2006-01-02|2006/01/02"`
+			To        *time.Time  `query:"to"         layout:"2006-01-02|2006/01/02"`
+			Days      []time.Time `query:"days"       layout:"2006-01-02|2006/01/02"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"created_at": {"must be a valid date"},
+			"from":       {"must be a valid date"},
+			"to":         {"must be a valid date"},
+			"days":       {"(Index: 1) must be a valid date"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name   string   `query:"name"`
+			Age    int      `query:"age"`
+			Roles  []string `query:"roles"`
+			Page   *int     `query:"page"`
+			UserID int      `query:"-"`
+		}
+
+		original := MyStruct{
+			Name:   "Jane",
+			Age:    20,
+			Roles:  []string{"guest"},
+			Page:   newPointer(3),
+			UserID: 7,
+		}
+
+		invalidQueryParams := map[string][]string{
+			"name":  {"John"},
+			"age":   {"thirty"},
+			"roles": {"admin"},
+		}
+
+		s := original
+		err := reqparse.ParseQuery(
+			invalidQueryParams, &s, &reqparse.ParseQueryOptions{Atomic: true},
+		)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, original, s)
+
+		validQueryParams := map[string][]string{
+			"name":  {"John"},
+			"age":   {"30"},
+			"roles": {"admin", "user"},
+			"page":  {"2"},
+		}
+
+		err = reqparse.ParseQuery(validQueryParams, &s, &reqparse.ParseQueryOptions{Atomic: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Name:   "John",
+			Age:    30,
+			Roles:  []string{"admin", "user"},
+			Page:   newPointer(2),
+			UserID: 7,
+		}, s)
+	})
+
+	t.Run("presence bools and negate", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			NoCache bool   `query:"no-cache"`
+			Cache   bool   `query:"no-cache" negate:"true"`
+			Debug   *bool  `query:"debug"`
+			Flags   []bool `query:"flags"`
+		}
+
+		opts := &reqparse.ParseQueryOptions{PresenceBools: true}
+
+		testCases := []struct {
+			name        string
+			queryParams map[string][]string
+			expected    MyStruct
+		}{
+			{
+				name:        "absent",
+				queryParams: map[string][]string{},
+				expected:    MyStruct{NoCache: false, Cache: true, Debug: nil, Flags: []bool{}},
+			},
+			{
+				name:        "valueless",
+				queryParams: map[string][]string{"no-cache": {""}, "debug": {""}},
+				expected: MyStruct{
+					NoCache: true, Cache: false, Debug: newPointer(true), Flags: []bool{},
+				},
+			},
+			{
+				name:        "explicit true",
+				queryParams: map[string][]string{"no-cache": {"true"}, "flags": {"", "false"}},
+				expected:    MyStruct{NoCache: true, Cache: false, Flags: []bool{true, false}},
+			},
+			{
+				name:        "explicit false",
+				queryParams: map[string][]string{"no-cache": {"false"}, "debug": {"false"}},
+				expected: MyStruct{
+					NoCache: false, Cache: true, Debug: newPointer(false), Flags: []bool{},
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			var s MyStruct
+			err := reqparse.ParseQuery(tc.queryParams, &s, opts)
+
+			require.NoError(t, err, tc.name)
+			assert.Equal(t, tc.expected, s, tc.name)
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"no-cache": {"maybe"}}, &s, opts)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"no-cache": {"must be a valid boolean", "must be a valid boolean"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("empty bool value without presence bools", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			NoCache bool `query:"no-cache"`
+			Debug   bool `query:"debug"    negate:"true" default:"true"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"no-cache": {""}}, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"no-cache": {"must be a valid boolean"},
+		}, validationError.FieldErrors)
+		assert.False(t, s.Debug)
+	})
+
+	t.Run("unique slice values", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"roles":   {"admin", "admin"},
+			"ids":     {"1", "01"},
+			"weights": {"1.5", "abc", "1.5"},
+			"flags":   {"true", "false"},
+			"tags":    {"a", "a"},
+		}
+
+		type MyStruct struct {
+			Roles   []string  `query:"roles"   unique:"true"`
+			IDs     []int     `query:"ids"     unique:"true"`
+			Weights []float64 `query:"weights" unique:"true"`
+			Flags   []bool    `query:"flags"   unique:"true"`
+			Tags    []string  `query:"tags"`
+			Pages   []int     `query:"pages"   unique:"true" default:"1,2,1"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"roles":   {"values must be unique"},
+			"ids":     {"values must be unique"},
+			"weights": {"(Index: 1) must be a valid float"},
+			"pages":   {"values must be unique"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, []bool{true, false}, s.Flags)
+		assert.Equal(t, []string{"a", "a"}, s.Tags)
+	})
+
+	t.Run("post process", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page     int `query:"page"`
+			PageSize int `query:"page_size" default:"20"`
+			Offset   int `query:"-"`
+		}
+
+		errInvalidPage := errors.New("invalid page")
+		opts := &reqparse.ParseQueryOptions{
+			PostProcess: func(target any) error {
+				s, ok := target.(*MyStruct)
+				if !ok {
+					return errors.New("unexpected target type")
+				}
+
+				if s.Page < 1 {
+					return errInvalidPage
+				}
+
+				s.Offset = (s.Page - 1) * s.PageSize
+
+				return nil
+			},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"page": {"3"}}, &s, opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Page: 3, PageSize: 20, Offset: 40}, s)
+
+		err = reqparse.ParseQuery(map[string][]string{"page": {"0"}}, &s, opts)
+
+		require.ErrorIs(t, err, errInvalidPage)
+		assert.EqualError(t, err, "post processing parsed query failed: invalid page")
+	})
+
+	t.Run("post process is not called on validation error", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int `query:"page"`
+		}
+
+		called := false
+		opts := &reqparse.ParseQueryOptions{
+			PostProcess: func(any) error {
+				called = true
+				return nil
+			},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"page": {"abc"}}, &s, opts)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.False(t, called)
+	})
+
+	t.Run("presets", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"page":      {"0"},
+			"page_size": {"500"},
+			"ids":       {"5", "-1", "abc"},
+			"offset":    {"-10"},
+			"limit":     {"10"},
+		}
+
+		errEven := errors.New("must be an even number")
+		opts := &reqparse.ParseQueryOptions{
+			Presets: map[string][]reqparse.Rule{
+				"pagination_page":      {reqparse.Min(1)},
+				"pagination_page_size": {reqparse.Min(1), reqparse.Max(100)},
+				"positive":             {reqparse.Min(0)},
+				"even": {func(value any) error {
+					if i, ok := value.(int); ok && i%2 != 0 {
+						return errEven
+					}
+
+					return nil
+				}},
+			},
+		}
+
+		type MyStruct struct {
+			Page     int   `query:"page"      preset:"pagination_page"`
+			PageSize *int  `query:"page_size" preset:"pagination_page_size"`
+			IDs      []int `query:"ids"       preset:"positive"`
+			Offset   *int  `query:"offset"    preset:"positive,even"`
+			Limit    int   `query:"limit"     preset:"even"`
+			Cursor   *int  `query:"cursor"    preset:"positive"`
+			Pages    []int `query:"pages"     preset:"positive"             unique:"true" default:"-1,-1"` //nolint:lll
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, opts)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"page":      {"must be greater than or equal to 1"},
+			"page_size": {"must be less than or equal to 100"},
+			"ids":       {"(Index: 2) must be a valid integer"},
+			"offset":    {"must be greater than or equal to 0"},
+			"pages": {
+				"values must be unique",
+				"(Index: 0) must be greater than or equal to 0",
+				"(Index: 1) must be greater than or equal to 0",
+			},
+		}, validationError.FieldErrors)
+		assert.Nil(t, s.Cursor)
+	})
+
+	t.Run("unknown preset", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int `query:"page" preset:"pagination_page"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrUnknownPreset)
+		assert.EqualError(t, err, "unknown validation preset: pagination_page (Page)")
+	})
+
+	t.Run("bool tokens", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"feature":  {"Enabled"},
+			"beta":     {"DISABLED"},
+			"features": {"on", "off", "yes", "no"},
+			"legacy":   {"true"},
+			"debug":    {"1"},
+		}
+
+		type MyStruct struct {
+			Feature  bool   `query:"feature"  booltokens:"enabled:disabled"`
+			Beta     *bool  `query:"beta"     booltokens:"enabled:disabled"`
+			Features []bool `query:"features" booltokens:"on,yes:off,no"`
+			Legacy   bool   `query:"legacy"   booltokens:"enabled:disabled"`
+			Debug    bool   `query:"debug"`
+			Hidden   bool   `query:"hidden"   booltokens:"shown:hidden"     negate:"true" default:"shown"` //nolint:lll
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"legacy": {"must be a valid boolean"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, MyStruct{
+			Feature:  true,
+			Beta:     newPointer(false),
+			Features: []bool{true, false, true, false},
+			Legacy:   false,
+			Debug:    true,
+			Hidden:   false,
+		}, s)
+	})
+
+	t.Run("invalid bool tokens tag", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Feature bool `query:"feature" booltokens:"enabled"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		assert.EqualError(t, err, `invalid struct tag value: booltokens:"enabled" (Feature)`)
+	})
+
+	t.Run("reject control chars", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"name":      {"John\x00"},
+			"city":      {"İstanbul 🌉"},
+			"tags":      {"ok", "new\nline", "tab\t"},
+			"nickname":  {"zero\u200bwidth"},
+			"meta[key]": {"\x1b[31m"},
+			"page":      {"1"},
+		}
+
+		type MyStruct struct {
+			Name     string            `query:"name"`
+			City     string            `query:"city"`
+			Tags     []string          `query:"tags"`
+			Nickname *string           `query:"nickname"`
+			Meta     map[string]string `query:"meta"`
+			Page     int               `query:"page"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			inputQueryParams, &s, &reqparse.ParseQueryOptions{RejectControlChars: true},
+		)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name": {"contains invalid characters"},
+			"tags": {
+				"(Index: 1) contains invalid characters",
+				"(Index: 2) contains invalid characters",
+			},
+			"nickname":  {"contains invalid characters"},
+			"meta[key]": {"contains invalid characters"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, "İstanbul 🌉", s.City)
+		assert.Equal(t, 1, s.Page)
+
+		s = MyStruct{}
+		err = reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "John\x00", s.Name)
+	})
+
+	t.Run("field error keys order", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"zeta":  {"a"},
+			"alpha": {"b"},
+			"mid":   {"1", "x", "y"},
+		}
+
+		type MyStruct struct {
+			Zeta  int    `query:"zeta"`
+			Mid   []int  `query:"mid"`
+			Name  string `query:"name"`
+			Alpha bool   `query:"alpha"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, []string{"zeta", "mid", "name", "alpha"}, validationError.FieldErrorKeys())
+		assert.Equal(
+			t,
+			"Parsing query parameters failed.\nStruct Errors:\nField Errors:\n"+
+				"\tzeta:\n\t\tmust be a valid integer\n"+
+				"\tmid:\n"+
+				"\t\t(Index: 1) must be a valid integer\n"+
+				"\t\t(Index: 2) must be a valid integer\n"+
+				"\tname:\n\t\tfield is required\n"+
+				"\talpha:\n\t\tmust be a valid boolean\n",
+			validationError.Error(),
+		)
+
+		manualError := reqparse.QueryValidationError{
+			FieldErrors: map[string][]string{
+				"b": {"error"},
+				"a": {"error"},
+			},
+		}
+		assert.Equal(t, []string{"a", "b"}, manualError.FieldErrorKeys())
+	})
+
+	t.Run("json marshaling", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int    `query:"page"`
+			Name string `query:"name"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"page": {"x"}}, &s, nil)
+		require.Error(t, err)
+
+		encoded, marshalErr := json.Marshal(err)
+		require.NoError(t, marshalErr)
+		assert.JSONEq(
+			t,
+			`{"field_errors": {"page": ["must be a valid integer"], `+
+				`"name": ["field is required"]}, "struct_errors": []}`,
+			string(encoded),
+		)
+
+		encoded, marshalErr = json.Marshal(&reqparse.QueryValidationError{})
+		require.NoError(t, marshalErr)
+		assert.Equal(t, `{"field_errors":{},"struct_errors":[]}`, string(encoded))
+	})
+
+	t.Run("error limits", func(t *testing.T) {
+		t.Parallel()
+
+		ids := make([]string, 1000)
+		for i := range ids {
+			ids[i] = "x" + strconv.Itoa(i)
+		}
+
+		inputQueryParams := map[string][]string{
+			"id":    ids,
+			"page":  {"x"},
+			"limit": {"x"},
+		}
+
+		type MyStruct struct {
+			IDs   []int  `query:"id"`
+			Page  int    `query:"page"`
+			Limit int    `query:"limit"`
+			Name  string `query:"name"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			MaxErrorsPerField: 2,
+		})
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.True(t, validationError.Truncated())
+		assert.Equal(t, map[string][]string{
+			"id":    {"(Index: 0) must be a valid integer", "(Index: 1) must be a valid integer"},
+			"page":  {"must be a valid integer"},
+			"limit": {"must be a valid integer"},
+			"name":  {"field is required"},
+		}, validationError.FieldErrors)
+		assert.Len(t, validationError.FieldErrorList(), 5)
+
+		err = reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+			MaxErrorsPerField: 2,
+			MaxTotalErrors:    3,
+		})
+		require.ErrorAs(t, err, &validationError)
+		assert.True(t, validationError.Truncated())
+		assert.Equal(t, map[string][]string{
+			"id":   {"(Index: 0) must be a valid integer", "(Index: 1) must be a valid integer"},
+			"page": {"must be a valid integer"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, []string{"id", "page"}, validationError.FieldErrorKeys())
+
+		err = reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.ErrorAs(t, err, &validationError)
+		assert.False(t, validationError.Truncated())
+		assert.Len(t, validationError.FieldErrors["id"], len(ids))
+	})
+
+	t.Run("field error list", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"page":  {"0"},
+			"id":    {"1", "x"},
+			"order": {"random"},
+		}
+
+		type MyStruct struct {
+			Page  int    `query:"page"  validate:"min=1"`
+			IDs   []int  `query:"id"`
+			Name  string `query:"name"`
+			Order string `query:"order" validate:"oneof=asc desc"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, []reqparse.FieldError{
+			{
+				Field:   "page",
+				Code:    reqparse.CodeMin,
+				Message: "must be greater than or equal to 1",
+				Params:  map[string]any{"min": float64(1)},
+				Source:  reqparse.SourceQuery,
+			},
+			{
+				Field:   "id",
+				Code:    reqparse.CodeInvalidInteger,
+				Message: "must be a valid integer",
+				Index:   newPointer(1),
+				Source:  reqparse.SourceQuery,
+			},
+			{
+				Field:   "name",
+				Code:    reqparse.CodeRequired,
+				Message: "field is required",
+				Source:  reqparse.SourceQuery,
+			},
+			{
+				Field:   "order",
+				Code:    reqparse.CodeOneOf,
+				Message: "must be one of: asc, desc",
+				Params:  map[string]any{"allowed": []string{"asc", "desc"}},
+				Source:  reqparse.SourceQuery,
+			},
+		}, validationError.FieldErrorList())
+
+		validationError.AddFieldError("custom", "custom error")
+		fieldErrors := validationError.FieldErrorList()
+		assert.Equal(t, reqparse.FieldError{
+			Field:   "custom",
+			Code:    reqparse.CodeInvalid,
+			Message: "custom error",
+			Source:  reqparse.SourceQuery,
+		}, fieldErrors[len(fieldErrors)-1])
+
+		validationError.AddFieldErrorDetail(reqparse.FieldError{
+			Field:   "custom",
+			Code:    reqparse.CodeMax,
+			Message: "must be less than or equal to 5",
+			Index:   newPointer(2),
+			Params:  map[string]any{"max": 5},
+		})
+		fieldErrors = validationError.FieldErrorList()
+		assert.Equal(t, reqparse.FieldError{
+			Field:   "custom",
+			Code:    reqparse.CodeMax,
+			Message: "must be less than or equal to 5",
+			Index:   newPointer(2),
+			Params:  map[string]any{"max": 5},
+			Source:  reqparse.SourceQuery,
+		}, fieldErrors[len(fieldErrors)-1])
+		assert.Equal(t, []string{
+			"custom error", "(Index: 2) must be less than or equal to 5",
+		}, validationError.FieldErrors["custom"])
+	})
+
+	t.Run("empty default of pointer fields", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"param5": {"5"},
+		}
+
+		type MyStruct struct {
+			Param1 *string    `query:"param1" default:""`
+			Param2 *int       `query:"param2" default:""`
+			Param3 *float64   `query:"param3" default:""`
+			Param4 *bool      `query:"param4" default:""`
+			Param5 *int       `query:"param5" default:""`
+			Param6 *time.Time `query:"param6" default:""`
+			Param7 *int       `query:"param7" default:""  required:"true"`
+		}
+
+		s := MyStruct{Param1: newPointer("stale"), Param2: newPointer(2)}
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Param1: nil,
+			Param2: nil,
+			Param3: nil,
+			Param4: nil,
+			Param5: newPointer(5),
+			Param6: nil,
+			Param7: nil,
+		}, s)
+	})
+
+	t.Run("duration params", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"timeout":   {"1m30s"},
+			"deadline":  {"30"},
+			"intervals": {"500ms", "2"},
+			"delay":     {"250"},
+			"retry":     {"1h"},
+		}
+
+		type MyStruct struct {
+			Timeout   time.Duration   `query:"timeout"`
+			Deadline  time.Duration   `query:"deadline"  durationunit:"s"`
+			Intervals []time.Duration `query:"intervals" durationunit:"s"`
+			Delay     *time.Duration  `query:"delay"     durationunit:"ms"`
+			Retry     *time.Duration  `query:"retry"     durationunit:"m"`
+			Grace     time.Duration   `query:"grace"     durationunit:"s"  default:"10"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Timeout:   90 * time.Second,
+			Deadline:  30 * time.Second,
+			Intervals: []time.Duration{500 * time.Millisecond, 2 * time.Second},
+			Delay:     newPointer(250 * time.Millisecond),
+			Retry:     newPointer(time.Hour),
+			Grace:     10 * time.Second,
+		}, s)
+	})
+
+	t.Run("duration params type casting validation error", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"timeout":   {"30"},
+			"deadline":  {"soon"},
+			"intervals": {"1s", "1.5"},
+			"delay":     {"99999999999999999"},
+		}
+
+		type MyStruct struct {
+			Timeout   time.Duration   `query:"timeout"`
+			Deadline  time.Duration   `query:"deadline"  durationunit:"s"`
+			Intervals []time.Duration `query:"intervals" durationunit:"s"`
+			Delay     *time.Duration  `query:"delay"     durationunit:"h"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"timeout":   {"must be a valid duration"},
+			"deadline":  {"must be a valid duration or number of seconds"},
+			"intervals": {"(Index: 1) must be a valid duration or number of seconds"},
+			"delay":     {"must be a valid duration or number of hours"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("invalid duration unit tag", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Timeout time.Duration `query:"timeout" durationunit:"sec"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		assert.EqualError(t, err, `invalid struct tag value: durationunit:"sec" (Timeout)`)
+	})
+
+	t.Run("repeated calls with different options", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Verbose bool              `query:"verbose"`
+			Name    string            `query:"name"`
+			Filter  map[string]string `query:"filter"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"verbose":      {""},
+			"name":         {"a\x00b"},
+			"filter.color": {"red"},
+		}
+
+		for i := 0; i < 2; i++ {
+			var s MyStruct
+			err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"verbose": {"must be a valid boolean"},
+			}, validationError.FieldErrors)
+
+			s = MyStruct{}
+			err = reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+				PresenceBools: true,
+				MapKeyStyle:   reqparse.MapKeyStyleDot,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, MyStruct{
+				Verbose: true,
+				Name:    "a\x00b",
+				Filter:  map[string]string{"color": "red"},
+			}, s)
+
+			err = reqparse.ParseQuery(inputQueryParams, &s, &reqparse.ParseQueryOptions{
+				PresenceBools:      true,
+				RejectControlChars: true,
+			})
+			require.ErrorAs(t, err, &validationError)
+			assert.Equal(t, map[string][]string{
+				"name": {"contains invalid characters"},
+			}, validationError.FieldErrors)
+		}
+	})
+
+	t.Run("validation error containers are non-nil", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int `query:"page"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, []string{}, validationError.StructErrors)
+		assert.Equal(t, map[string][]string{
+			"page": {"field is required"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("uint params", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":     {"42"},
+			"ids":    {"1", "18446744073709551615"},
+			"count":  {"7"},
+			"weight": {"12kg"},
+		}
+
+		type MyStruct struct {
+			ID     uint   `query:"id"`
+			IDs    []uint `query:"ids"`
+			Count  *uint  `query:"count"`
+			Limit  *uint  `query:"limit"`
+			Weight uint   `query:"weight" stripsuffix:"kg"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		count := uint(7)
+		assert.Equal(t, MyStruct{
+			ID:     42,
+			IDs:    []uint{1, 18446744073709551615},
+			Count:  &count,
+			Weight: 12,
+		}, s)
+	})
+
+	t.Run("uint params type casting validation error", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":    {"-1"},
+			"ids":   {"1", "x", "18446744073709551616"},
+			"count": {"1.5"},
+		}
+
+		type MyStruct struct {
+			ID    uint   `query:"id"`
+			IDs   []uint `query:"ids"`
+			Count *uint  `query:"count"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"id": {"must be a valid unsigned integer"},
+			"ids": {
+				"(Index: 1) must be a valid unsigned integer",
+				"(Index: 2) must be between 0 and 18446744073709551615",
+			},
+			"count": {"must be a valid unsigned integer"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("sized integer params", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"i8":  {"-128"},
+			"i16": {"32767"},
+			"i32": {"-2147483648", "2147483647"},
+			"i64": {"-9223372036854775808"},
+			"u8":  {"255"},
+			"u16": {"65535"},
+			"u32": {"4294967295"},
+			"u64": {"18446744073709551615"},
+		}
+
+		type MyStruct struct {
+			I8  int8    `query:"i8"`
+			I16 *int16  `query:"i16"`
+			I32 []int32 `query:"i32"`
+			I64 int64   `query:"i64"`
+			U8  uint8   `query:"u8"`
+			U16 *uint16 `query:"u16"`
+			U32 uint32  `query:"u32"`
+			U64 uint64  `query:"u64"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		i16, u16 := int16(32767), uint16(65535)
+		assert.Equal(t, MyStruct{
+			I8:  -128,
+			I16: &i16,
+			I32: []int32{-2147483648, 2147483647},
+			I64: -9223372036854775808,
+			U8:  255,
+			U16: &u16,
+			U32: 4294967295,
+			U64: 18446744073709551615,
+		}, s)
+	})
+
+	t.Run("sized integer params overflow", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"i8":  {"128"},
+			"i16": {"-32769"},
+			"i32": {"1", "2147483648"},
+			"i64": {"9223372036854775808"},
+			"u8":  {"256"},
+			"u16": {"-1"},
+			"u32": {"4294967296"},
+		}
+
+		type MyStruct struct {
+			I8  int8    `query:"i8"`
+			I16 *int16  `query:"i16"`
+			I32 []int32 `query:"i32"`
+			I64 int64   `query:"i64"`
+			U8  uint8   `query:"u8"`
+			U16 *uint16 `query:"u16"`
+			U32 uint32  `query:"u32"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"i8":  {"must be between -128 and 127"},
+			"i16": {"must be between -32768 and 32767"},
+			"i32": {"(Index: 1) must be between -2147483648 and 2147483647"},
+			"i64": {"must be between -9223372036854775808 and 9223372036854775807"},
+			"u8":  {"must be between 0 and 255"},
+			"u16": {"must be a valid unsigned integer"},
+			"u32": {"must be between 0 and 4294967295"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("time format tag", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"from":    {"2024-05-01"},
+			"until":   {"2024-05-01T10:20:30.5Z"},
+			"created": {"1714558830"},
+			"updated": {"1714558830500", "-1500"},
+			"custom":  {"01/05/2024"},
+		}
+
+		type MyStruct struct {
+			From    time.Time   `query:"from"    timeformat:"date"`
+			Until   *time.Time  `query:"until"   timeformat:"rfc3339nano"`
+			Created time.Time   `query:"created" timeformat:"unix"`
+			Updated []time.Time `query:"updated" timeformat:"unixmilli"`
+			Custom  time.Time   `query:"custom"  timeformat:"02/01/2006"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		until := time.Date(2024, 5, 1, 10, 20, 30, 500000000, time.UTC)
+		assert.Equal(t, MyStruct{
+			From:    time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			Until:   &until,
+			Created: time.Date(2024, 5, 1, 10, 20, 30, 0, time.UTC),
+			Updated: []time.Time{
+				time.Date(2024, 5, 1, 10, 20, 30, 500000000, time.UTC),
+				time.Date(1969, 12, 31, 23, 59, 58, 500000000, time.UTC),
+			},
+			Custom: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		}, s)
+	})
+
+	t.Run("time format tag validation error", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"from":    {"2024-05-01T10:20:30Z"},
+			"created": {"1714558830.5"},
+			"custom":  {"2024-05-01"},
+		}
+
+		type MyStruct struct {
+			From    time.Time  `query:"from"    timeformat:"date"`
+			Created *time.Time `query:"created" timeformat:"unix"`
+			Custom  time.Time  `query:"custom"  timeformat:"02/01/2006"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"from":    {"must be a valid date/time in format date"},
+			"created": {"must be a valid date/time in format unix"},
+			"custom":  {"must be a valid date/time in format 02/01/2006"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("invalid time format tag", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			From time.Time `query:"from" timeformat:"date" layout:"2006-01-02"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		assert.EqualError(t, err, `invalid struct tag value: timeformat:"date" (From)`)
+	})
+
+	t.Run("slice of pointers params", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			IDs    []*int    `query:"ids"`
+			Names  []*string `query:"names"  unique:"true"`
+			Scores []*int    `query:"scores" default:"1,,3"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"ids":   {"1", "", "3"},
+			"names": {"a", "b"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		one, three := 1, 3
+		a, b := "a", "b"
+		assert.Equal(t, MyStruct{
+			IDs:    []*int{&one, nil, &three},
+			Names:  []*string{&a, &b},
+			Scores: []*int{&one, nil, &three},
+		}, s)
+	})
+
+	t.Run("slice of pointers params validation error", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			IDs   []*int    `query:"ids"   preset:"positive"`
+			Names []*string `query:"names" unique:"true"`
+		}
+
+		opts := &reqparse.ParseQueryOptions{Presets: map[string][]reqparse.Rule{
+			"positive": {reqparse.Min(1)},
+		}}
+
+		inputQueryParams := map[string][]string{
+			"ids":   {"1", "x", ""},
+			"names": {"a", "a"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, opts)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"ids":   {"(Index: 1) must be a valid integer"},
+			"names": {"values must be unique"},
+		}, validationError.FieldErrors)
+
+		err = reqparse.ParseQuery(map[string][]string{"ids": {"1", "-2", ""}}, &s, opts)
+
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"ids": {"(Index: 1) must be greater than or equal to 1"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("pointer to slice params", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Tags    *[]string `query:"tags"`
+			IDs     *[]int    `query:"ids"     unique:"true"`
+			Colors  *[]string `query:"colors"`
+			Sizes   *[]int    `query:"sizes"   default:"1,2"`
+			Missing *[]string `query:"missing"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"tags":   {"a", "b"},
+			"ids":    {"1", "2"},
+			"colors": {""},
+		}
+
+		s := MyStruct{Missing: &[]string{"stale"}}
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Tags:   &[]string{"a", "b"},
+			IDs:    &[]int{1, 2},
+			Colors: &[]string{},
+			Sizes:  &[]int{1, 2},
+		}, s)
+	})
+
+	t.Run("pointer to slice params validation error", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			IDs   *[]int `query:"ids"   unique:"true"`
+			Sizes *[]int `query:"sizes"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"ids":   {"1", "1"},
+			"sizes": {"1", "x"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"ids":   {"values must be unique"},
+			"sizes": {"(Index: 1) must be a valid integer"},
+		}, validationError.FieldErrors)
+	})
 }
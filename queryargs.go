@@ -0,0 +1,82 @@
+package reqparse
+
+import "reflect"
+
+// QueryArgs is a source of query parameters that are not stored as map[string][]string, e.g. the
+// query arguments of a fasthttp request. It is used by [ParseQueryArgs].
+type QueryArgs interface {
+	// Values returns the values of the query parameter in order. It returns an empty slice if the
+	// query parameter is not present.
+	Values(key string) []string
+
+	// VisitAll calls f for each value of each query parameter in order.
+	VisitAll(f func(key, value string))
+}
+
+// ParseQueryArgs parses the query parameters of args into given struct the same way as
+// [ParseQuery], without converting all of them into a map. The query parameters of the fields are
+// looked up with the Values method of args. The VisitAll method is used only for the structs with
+// nested, embedded, map or catch-all fields, and for the indexed array keys of the slice fields
+// without values, so these fields are bound like they are from a map. If options are nil, default
+// options are used.
+func ParseQueryArgs(args QueryArgs, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseQueryArgs(args, target)
+}
+
+// parseQueryArgs is the implementation of [Parser.ParseQueryArgs]. opts must be non-nil.
+func parseQueryArgs(args QueryArgs, target any, opts *ParseQueryOptions) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryTarget
+	}
+
+	plan, err := cachedStructPlan(v.Elem().Type(), querySource, opts)
+	if err != nil {
+		return err
+	}
+
+	return plan.bind(queryArgsValues(args, plan), v, querySource, opts)
+}
+
+// queryArgsValues returns the query parameters of args bound by the plan. If the keys of the plan
+// are known in advance (see [structPlan.hasKnownKeys]), only the values of the field keys are
+// looked up, and VisitAll is used only for the indexed array keys of the slice fields without
+// values. Otherwise all of the query parameters are copied.
+func queryArgsValues(args QueryArgs, plan *structPlan) map[string][]string {
+	if !plan.hasKnownKeys() {
+		values := make(map[string][]string)
+
+		args.VisitAll(func(key, value string) {
+			values[key] = append(values[key], value)
+		})
+
+		return values
+	}
+
+	values := make(map[string][]string, len(plan.fields))
+
+	var sliceKeys []string
+
+	for i := range plan.fields {
+		field := &plan.fields[i]
+
+		if fieldValues := args.Values(field.key); len(fieldValues) > 0 {
+			values[field.key] = fieldValues
+		} else if field.kind == reflect.Slice || field.pointerToSlice {
+			sliceKeys = append(sliceKeys, field.key)
+		}
+	}
+
+	if len(sliceKeys) > 0 {
+		// Slices can be bound from indexed array keys too, e.g. `items[0]=a&items[1]=b`.
+		args.VisitAll(func(key, value string) {
+			for _, sliceKey := range sliceKeys {
+				if _, ok := arrayIndexFromKey(key, sliceKey); ok {
+					values[key] = append(values[key], value)
+				}
+			}
+		})
+	}
+
+	return values
+}
@@ -0,0 +1,166 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orderedArgs is a [reqparse.QueryArgs] keeping the query parameters in order, recording the
+// looked up keys and counting the visits.
+type orderedArgs struct {
+	pairs      [][2]string
+	lookedUp   []string
+	visitCount int
+}
+
+func (a *orderedArgs) Values(key string) []string {
+	a.lookedUp = append(a.lookedUp, key)
+
+	values := make([]string, 0)
+	for _, pair := range a.pairs { //nolint:wsl
+		if pair[0] == key {
+			values = append(values, pair[1])
+		}
+	}
+
+	return values
+}
+
+func (a *orderedArgs) VisitAll(f func(key, value string)) {
+	a.visitCount++
+
+	for _, pair := range a.pairs {
+		f(pair[0], pair[1])
+	}
+}
+
+func TestParseQueryArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int      `query:"page" default:"1"`
+			Tags []string `query:"tag"`
+			Note string   `query:"-"`
+		}
+
+		args := &orderedArgs{pairs: [][2]string{{"tag", "a"}, {"other", "1"}, {"tag", "b"}}}
+
+		var s MyStruct
+		require.NoError(t, reqparse.ParseQueryArgs(args, &s, nil))
+
+		assert.Equal(t, MyStruct{Page: 1, Tags: []string{"a", "b"}}, s)
+		assert.Equal(t, []string{"page", "tag"}, args.lookedUp)
+		assert.Equal(t, 0, args.visitCount)
+	})
+
+	t.Run("indexed array keys", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int   `query:"page"`
+			IDs  []int `query:"ids"`
+		}
+
+		args := &orderedArgs{pairs: [][2]string{{"ids[1]", "2"}, {"page", "3"}, {"ids[0]", "1"}}}
+
+		var s MyStruct
+		require.NoError(t, reqparse.ParseQueryArgs(args, &s, nil))
+
+		assert.Equal(t, MyStruct{Page: 3, IDs: []int{1, 2}}, s)
+		assert.Equal(t, []string{"page", "ids"}, args.lookedUp)
+		assert.Equal(t, 1, args.visitCount)
+	})
+
+	t.Run("map fields", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page   int               `query:"page"   default:"1"`
+			Filter map[string]string `query:"filter"`
+		}
+
+		args := &orderedArgs{pairs: [][2]string{
+			{"filter[color]", "red"}, {"filter", "x"}, {"other", "1"},
+		}}
+
+		var s MyStruct
+		require.NoError(t, reqparse.ParseQueryArgs(args, &s, nil))
+
+		assert.Equal(t, MyStruct{Page: 1, Filter: map[string]string{"color": "red"}}, s)
+		assert.Empty(t, args.lookedUp)
+		assert.Equal(t, 1, args.visitCount)
+	})
+
+	t.Run("nested, indexed and catch-all fields", func(t *testing.T) {
+		t.Parallel()
+
+		type Filter struct {
+			Color string `query:"color"`
+		}
+
+		type Base struct {
+			Limit int `query:"limit"`
+		}
+
+		type MyStruct struct {
+			Base
+			Filter Filter              `query:"filter"`
+			IDs    []int               `query:"ids"`
+			Others map[string][]string `query:"*"`
+		}
+
+		args := &orderedArgs{pairs: [][2]string{
+			{"limit", "10"}, {"filter.color", "red"}, {"ids[1]", "2"}, {"ids[0]", "1"}, {"x", "y"},
+		}}
+
+		var s MyStruct
+		require.NoError(t, reqparse.ParseQueryArgs(args, &s, nil))
+
+		assert.Equal(t, MyStruct{
+			Base:   Base{Limit: 10},
+			Filter: Filter{Color: "red"},
+			IDs:    []int{1, 2},
+			Others: map[string][]string{"x": {"y"}},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page  int  `query:"page"`
+			Limit *int `query:"limit"`
+		}
+
+		args := &orderedArgs{pairs: [][2]string{{"limit", "x"}}}
+
+		var s MyStruct
+		err := reqparse.NewParser(nil).ParseQueryArgs(args, &s)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"page":  {"field is required"},
+			"limit": {"must be a valid integer"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("query tag not found", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Page int
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQueryArgs(&orderedArgs{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrQueryTagNotFound)
+	})
+}
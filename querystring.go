@@ -0,0 +1,102 @@
+package reqparse
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ParseQueryValues parses query parameters into given struct. It is the same as [ParseQuery] since
+// [url.Values] is a map[string][]string; it is provided for discoverability. If options are nil,
+// default options are used.
+func ParseQueryValues(queryParams url.Values, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseQuery(queryParams, target)
+}
+
+// ParseQueryRequest parses the query parameters of the request into given struct. The raw query
+// string of the request URL is parsed with [ParseQueryString], so the values are bound exactly like
+// ParseQuery(r.URL.Query(), ...) binds them. If options are nil, default options are used.
+//
+// The parsed query is not cached: each call looks up the values of the fields in the raw query
+// string again. Caching it per request would require decoding all of the query parameters into a
+// map, which is what [ParseQueryString] avoids.
+func ParseQueryRequest(r *http.Request, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseQueryRequest(r, target)
+}
+
+// ParseQueryString parses the raw query string, e.g. [url.URL.RawQuery], into given struct the same
+// way as [ParseQuery]. The query string is not converted into a map: the values are looked up by
+// the keys of the fields, so the query parameters that are not bound are not decoded, and
+// components without percent-encoding are not copied. Pairs with invalid percent-encoding or
+// semicolons are ignored, as [url.URL.Query] does. If options are nil, default options are used.
+func ParseQueryString(rawQuery string, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseQueryString(rawQuery, target)
+}
+
+// rawQueryArgs is a [QueryArgs] reading the query parameters from a raw query string.
+type rawQueryArgs string
+
+// VisitAll calls f for each decoded key-value pair of the query string in order.
+func (q rawQueryArgs) VisitAll(f func(key, value string)) {
+	for query := string(q); query != ""; {
+		key, rawValue, rest, ok := nextQueryPair(query)
+		query = rest
+
+		if !ok {
+			continue
+		}
+
+		if value, ok := unescapeQueryComponent(rawValue); ok {
+			f(key, value)
+		}
+	}
+}
+
+// Values returns the decoded values of the query parameter in order. Values of the other query
+// parameters are not decoded.
+func (q rawQueryArgs) Values(key string) []string {
+	var values []string
+
+	for query := string(q); query != ""; {
+		pairKey, rawValue, rest, ok := nextQueryPair(query)
+		query = rest
+
+		if !ok || pairKey != key {
+			continue
+		}
+
+		if value, ok := unescapeQueryComponent(rawValue); ok {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// nextQueryPair cuts the first pair of the query string. It returns the decoded key and the raw
+// value of the pair, and the rest of the query string. It returns false if the pair is empty,
+// contains a semicolon or its key has invalid percent-encoding.
+func nextQueryPair(query string) (string, string, string, bool) {
+	pair, rest, _ := strings.Cut(query, "&")
+	if pair == "" || strings.Contains(pair, ";") {
+		return "", "", rest, false
+	}
+
+	key, rawValue, _ := strings.Cut(pair, "=")
+
+	key, ok := unescapeQueryComponent(key)
+
+	return key, rawValue, rest, ok
+}
+
+// unescapeQueryComponent decodes the percent-encoded query component. It returns false if the
+// encoding is invalid. Components without escapes are returned without allocating.
+func unescapeQueryComponent(s string) (string, bool) {
+	if !strings.ContainsAny(s, "%+") {
+		return s, true
+	}
+
+	s, err := url.QueryUnescape(s)
+
+	return s, err == nil
+}
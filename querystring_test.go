@@ -0,0 +1,226 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryString(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Query   string            `query:"q"`
+		Page    int               `query:"page"    default:"1"`
+		Tags    []string          `query:"tag"`
+		Verbose *bool             `query:"verbose"`
+		Filter  map[string]string `query:"filter"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		rawQuery := "q=red+shoes%21&tag=a&filter%5Bcolor%5D=red&tag=b%20c&other=1&&verbose=1"
+
+		var s MyStruct
+		require.NoError(t, reqparse.ParseQueryString(rawQuery, &s, nil))
+
+		verbose := true
+		assert.Equal(t, MyStruct{
+			Query:   "red shoes!",
+			Page:    1,
+			Tags:    []string{"a", "b c"},
+			Verbose: &verbose,
+			Filter:  map[string]string{"color": "red"},
+		}, s)
+	})
+
+	t.Run("same as ParseQuery", func(t *testing.T) {
+		t.Parallel()
+
+		rawQueries := []string{
+			"",
+			"q",
+			"q=&page=2",
+			"q=a=b&page=%zz&page=3",
+			"q=a;b&q=c&tag=1;2&tag=3",
+			"q=%E2%82%AC&verbose=maybe&tag",
+			"q=x&filter[a]=1&filter[a]=2&filter[b%5D=3",
+			"tag[1]=b&q=a&tag%5B0%5D=a&tag=",
+		}
+
+		for _, rawQuery := range rawQueries {
+			queryParams, _ := url.ParseQuery(rawQuery)
+
+			var expected, actual MyStruct
+			expectedErr := reqparse.ParseQuery(queryParams, &expected, nil)
+			actualErr := reqparse.NewParser(nil).ParseQueryString(rawQuery, &actual)
+
+			assert.Equal(t, expected, actual, rawQuery)
+			assert.Equal(t, expectedErr, actualErr, rawQuery)
+		}
+	})
+
+	t.Run("nested, indexed and catch-all fields", func(t *testing.T) {
+		t.Parallel()
+
+		type Filter struct {
+			Color string `query:"color"`
+		}
+
+		type OtherStruct struct {
+			Filter Filter              `query:"filter"`
+			IDs    []int               `query:"ids"`
+			Others map[string][]string `query:"*"`
+		}
+
+		rawQuery := "filter.color=red&ids%5B1%5D=2&ids[0]=1&x=y"
+		queryParams, _ := url.ParseQuery(rawQuery)
+
+		var expected, actual OtherStruct
+		require.NoError(t, reqparse.ParseQuery(queryParams, &expected, nil))
+		require.NoError(t, reqparse.ParseQueryString(rawQuery, &actual, nil))
+
+		assert.Equal(t, OtherStruct{
+			Filter: Filter{Color: "red"},
+			IDs:    []int{1, 2},
+			Others: map[string][]string{"x": {"y"}},
+		}, actual)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("parameters without values are present", func(t *testing.T) {
+		t.Parallel()
+
+		type RequiredStruct struct {
+			IDs  []int    `query:"ids"  required:"true"`
+			Tags []string `query:"tags" required:"true"`
+		}
+
+		var s RequiredStruct
+		err := reqparse.ParseQueryString("ids=&tags", &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"ids": {"(Index: 0) must be a valid integer"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, []string{""}, s.Tags)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQueryString("q=a", s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryTarget)
+	})
+}
+
+// Allocations of the parallel tests would be counted too, so this test isn't parallel.
+//
+//nolint:paralleltest
+func TestParseQueryStringAllocs(t *testing.T) {
+	type MyStruct struct {
+		Query string   `query:"q"`
+		Page  int      `query:"page" default:"1"`
+		Tags  []string `query:"tag"`
+	}
+
+	allocs := func(rawQuery string) float64 {
+		return testing.AllocsPerRun(100, func() {
+			var s MyStruct
+			_ = reqparse.ParseQueryString(rawQuery, &s, nil)
+		})
+	}
+
+	rawQuery := "q=shoes&page=2&tag=a&tag=b"
+
+	// Query parameters that are not bound are neither decoded nor copied into a map.
+	assert.Equal(t, allocs(rawQuery), allocs(
+		rawQuery+"&utm_source=newsletter&utm_medium=email&utm_campaign=spring%20sale&ref=home",
+	))
+}
+
+func TestParseQueryValues(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Page int      `query:"page"`
+		Tags []string `query:"tag"`
+	}
+
+	var s MyStruct
+	err := reqparse.ParseQueryValues(url.Values{"page": {"2"}, "tag": {"a", "b"}}, &s, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, MyStruct{Page: 2, Tags: []string{"a", "b"}}, s)
+}
+
+func TestParseQueryRequest(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Page int      `query:"page"`
+		Tags []string `query:"tag"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/items?page=2&tag=a&tag=b+c", nil)
+
+		var s MyStruct
+		require.NoError(t, reqparse.ParseQueryRequest(r, &s, nil))
+		assert.Equal(t, MyStruct{Page: 2, Tags: []string{"a", "b c"}}, s)
+	})
+
+	t.Run("nested struct and indexed slice", func(t *testing.T) {
+		t.Parallel()
+
+		type Filter struct {
+			Color string `query:"color"`
+			Size  *int   `query:"size"`
+		}
+
+		type OtherStruct struct {
+			Filter Filter `query:"filter" style:"deepObject"`
+			IDs    []int  `query:"ids"`
+		}
+
+		r := httptest.NewRequest(
+			http.MethodGet, "/items?filter%5Bcolor%5D=red&ids%5B1%5D=20&ids%5B0%5D=10", nil,
+		)
+
+		var expected, actual OtherStruct
+		require.NoError(t, reqparse.ParseQuery(r.URL.Query(), &expected, nil))
+		require.NoError(t, reqparse.ParseQueryRequest(r, &actual, nil))
+
+		assert.Equal(t, OtherStruct{
+			Filter: Filter{Color: "red"},
+			IDs:    []int{10, 20},
+		}, actual)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/items?page=x", nil)
+
+		var s MyStruct
+		err := reqparse.NewParser(nil).ParseQueryRequest(r, &s)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page": {"must be a valid integer"},
+		}, validationErr.FieldErrors)
+	})
+}
@@ -0,0 +1,174 @@
+package reqparse
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var errInvalidRange = newCodedError( //nolint:gochecknoglobals
+	CodeInvalidRange, "must be a valid range", nil,
+)
+
+var rangeHeaderType = reflect.TypeOf(RangeHeader{}) //nolint:gochecknoglobals
+
+// ByteRange is a range of bytes resolved against a content length. Start and End are inclusive
+// offsets, so a range of a single byte has the same Start and End.
+type ByteRange struct {
+	Start int64
+	End   int64
+}
+
+// Length returns the number of bytes in the range.
+func (br ByteRange) Length() int64 {
+	return br.End - br.Start + 1
+}
+
+// RangeHeader is the parsed value of a Range header whose ranges are not resolved against a
+// content length yet. It can be used as the type of a field bound with the `header` tag, e.g.
+// `header:"Range"`; invalid values are reported with "must be a valid range" validation error.
+type RangeHeader struct {
+	specs []rangeSpec
+}
+
+// rangeSpec is a single range of a Range header. first is -1 for suffix ranges, e.g. "-500", and
+// last is -1 for ranges without the last position, e.g. "9500-".
+type rangeSpec struct {
+	first int64
+	last  int64
+}
+
+// ParseRangeHeader parses the value of a Range header with the bytes unit, e.g.
+// "bytes=0-499,-500". It returns [ErrInvalidRange] if the value is malformed.
+func ParseRangeHeader(value string) (RangeHeader, error) {
+	unit, rangeSet, ok := strings.Cut(value, "=")
+	if !ok || !strings.EqualFold(strings.TrimSpace(unit), "bytes") {
+		return RangeHeader{}, ErrInvalidRange
+	}
+
+	var header RangeHeader
+
+	for _, rangeValue := range strings.Split(rangeSet, ",") {
+		rangeValue = strings.TrimSpace(rangeValue)
+		if rangeValue == "" {
+			// Empty list elements are allowed by the list syntax of RFC 9110.
+			continue
+		}
+
+		spec, err := parseRangeSpec(rangeValue)
+		if err != nil {
+			return RangeHeader{}, err
+		}
+
+		header.specs = append(header.specs, spec)
+	}
+
+	if len(header.specs) == 0 {
+		return RangeHeader{}, ErrInvalidRange
+	}
+
+	return header, nil
+}
+
+// parseRangeSpec parses a single range of a Range header, e.g. "0-499", "-500" or "9500-".
+func parseRangeSpec(value string) (rangeSpec, error) {
+	firstValue, lastValue, ok := strings.Cut(value, "-")
+	if !ok || (firstValue == "" && lastValue == "") {
+		return rangeSpec{}, ErrInvalidRange
+	}
+
+	spec := rangeSpec{first: -1, last: -1}
+
+	if firstValue != "" {
+		first, err := parseRangePosition(firstValue)
+		if err != nil {
+			return rangeSpec{}, err
+		}
+
+		spec.first = first
+	}
+
+	if lastValue != "" {
+		last, err := parseRangePosition(lastValue)
+		if err != nil {
+			return rangeSpec{}, err
+		}
+
+		spec.last = last
+	}
+
+	if spec.first >= 0 && spec.last >= 0 && spec.first > spec.last {
+		return rangeSpec{}, ErrInvalidRange
+	}
+
+	return spec, nil
+}
+
+// parseRangePosition parses a non-negative decimal position of a range.
+func parseRangePosition(value string) (int64, error) {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return 0, ErrInvalidRange
+		}
+	}
+
+	position, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidRange
+	}
+
+	return position, nil
+}
+
+// ByteRanges resolves the ranges against the content length. Ranges starting after the content
+// are skipped and the other ranges are clipped to the content. If none of the ranges overlaps the
+// content, [ErrRangeNotSatisfiable] is returned.
+func (h RangeHeader) ByteRanges(size int64) ([]ByteRange, error) {
+	ranges := make([]ByteRange, 0, len(h.specs))
+
+	for _, spec := range h.specs {
+		var byteRange ByteRange
+
+		switch {
+		case spec.first < 0:
+			// Suffix range: the last N bytes of the content.
+			if spec.last == 0 {
+				continue
+			}
+
+			byteRange = ByteRange{Start: size - spec.last, End: size - 1}
+			if byteRange.Start < 0 {
+				byteRange.Start = 0
+			}
+		default:
+			byteRange = ByteRange{Start: spec.first, End: spec.last}
+			if spec.last < 0 || spec.last >= size {
+				byteRange.End = size - 1
+			}
+		}
+
+		if byteRange.Start >= size || byteRange.End < byteRange.Start {
+			continue
+		}
+
+		ranges = append(ranges, byteRange)
+	}
+
+	if len(ranges) == 0 {
+		return nil, ErrRangeNotSatisfiable
+	}
+
+	return ranges, nil
+}
+
+// ParseRange parses the value of a Range header and resolves its ranges against the content
+// length. It returns [ErrInvalidRange] if the value is malformed and [ErrRangeNotSatisfiable] if
+// none of the ranges overlaps the content, which is typically responded with 416 status code.
+func ParseRange(value string, size int64) ([]ByteRange, error) {
+	header, err := ParseRangeHeader(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return header.ByteRanges(size)
+}
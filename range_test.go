@@ -0,0 +1,180 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		value    string
+		size     int64
+		expected []reqparse.ByteRange
+		err      error
+	}{
+		{
+			name:     "single range",
+			value:    "bytes=0-499",
+			size:     1000,
+			expected: []reqparse.ByteRange{{Start: 0, End: 499}},
+		},
+		{
+			name:  "multiple ranges",
+			value: "bytes=0-0, 500-999 ,-100, 900-",
+			size:  1000,
+			expected: []reqparse.ByteRange{
+				{Start: 0, End: 0},
+				{Start: 500, End: 999},
+				{Start: 900, End: 999},
+				{Start: 900, End: 999},
+			},
+		},
+		{
+			name:     "clipped to content",
+			value:    "Bytes=500-2000,-5000",
+			size:     1000,
+			expected: []reqparse.ByteRange{{Start: 500, End: 999}, {Start: 0, End: 999}},
+		},
+		{
+			name:     "unsatisfiable ranges are skipped",
+			value:    "bytes=1000-1100,0-9,-0",
+			size:     1000,
+			expected: []reqparse.ByteRange{{Start: 0, End: 9}},
+		},
+		{
+			name:  "not satisfiable",
+			value: "bytes=1000-",
+			size:  1000,
+			err:   reqparse.ErrRangeNotSatisfiable,
+		},
+		{name: "empty content", value: "bytes=-10", size: 0, err: reqparse.ErrRangeNotSatisfiable},
+		{name: "missing unit", value: "0-499", size: 1000, err: reqparse.ErrInvalidRange},
+		{name: "other unit", value: "items=0-4", size: 1000, err: reqparse.ErrInvalidRange},
+		{name: "empty range set", value: "bytes=,", size: 1000, err: reqparse.ErrInvalidRange},
+		{name: "first after last", value: "bytes=5-4", size: 1000, err: reqparse.ErrInvalidRange},
+		{name: "only dash", value: "bytes=-", size: 1000, err: reqparse.ErrInvalidRange},
+		{name: "signed position", value: "bytes=+1-5", size: 1000, err: reqparse.ErrInvalidRange},
+		{name: "not a number", value: "bytes=a-5", size: 1000, err: reqparse.ErrInvalidRange},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ranges, err := reqparse.ParseRange(tc.value, tc.size)
+
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ranges)
+		})
+	}
+
+	assert.Equal(t, int64(500), reqparse.ByteRange{Start: 0, End: 499}.Length())
+}
+
+func TestParseHeader(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Range     *reqparse.RangeHeader `header:"range"`
+		RequestID string                `header:"X-Request-Id"`
+		Languages []string              `header:"Accept-Language"`
+		Ignored   string                `header:"-"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Range", "bytes=0-99,-10")
+		header.Set("X-Request-Id", "abc")
+		header.Add("Accept-Language", "tr")
+		header.Add("Accept-Language", "en")
+
+		var s MyStruct
+		err := reqparse.ParseHeader(header, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "abc", s.RequestID)
+		assert.Equal(t, []string{"tr", "en"}, s.Languages)
+		require.NotNil(t, s.Range)
+
+		ranges, err := s.Range.ByteRanges(1000)
+
+		require.NoError(t, err)
+		assert.Equal(t, []reqparse.ByteRange{{Start: 0, End: 99}, {Start: 990, End: 999}}, ranges)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		header := http.Header{}
+		header.Set("Range", "bytes=9-1")
+
+		var s MyStruct
+		err := reqparse.ParseHeader(header, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"range":        {"must be a valid range"},
+			"X-Request-Id": {"field is required"},
+		}, validationError.FieldErrors)
+		assert.Contains(t, validationError.Error(), "Parsing headers failed.")
+	})
+
+	t.Run("nested and embedded structs", func(t *testing.T) {
+		t.Parallel()
+
+		type RateHeaders struct {
+			Limit     int `header:"Limit"`
+			Remaining int `header:"remaining"`
+		}
+
+		type TraceHeaders struct {
+			TraceID string `header:"x-trace-id"`
+		}
+
+		type NestedStruct struct {
+			TraceHeaders
+			Rate RateHeaders `header:"X-Rate"`
+		}
+
+		header := http.Header{}
+		header.Set("X-Trace-Id", "abc")
+		header.Set("X-Rate.Limit", "100")
+		header.Set("X-Rate.Remaining", "42")
+
+		var s NestedStruct
+		err := reqparse.ParseHeader(header, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "abc", s.TraceID)
+		assert.Equal(t, RateHeaders{Limit: 100, Remaining: 42}, s.Rate)
+	})
+
+	t.Run("header tag not found", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			RequestID string `query:"request_id"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseHeader(http.Header{}, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrHeaderTagNotFound)
+	})
+}
@@ -0,0 +1,299 @@
+package reqparse
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+)
+
+var (
+	headerSource = bindingSource{ //nolint:gochecknoglobals
+		source:         SourceHeader,
+		tagName:        "header",
+		errTagNotFound: ErrHeaderTagNotFound,
+		description:    "headers",
+	}
+
+	cookieSource = bindingSource{ //nolint:gochecknoglobals
+		source:      SourceCookie,
+		tagName:     "cookie",
+		description: "cookies",
+	}
+
+	// requestSources are the sources of [ParseRequest] other than the JSON body, in the order of
+	// precedence.
+	requestSources = []bindingSource{ //nolint:gochecknoglobals
+		pathSource, querySource, headerSource, cookieSource,
+	}
+)
+
+// ParseHeader parses request headers into given struct. Header names are specified by the
+// `header` tag of the fields and are case-insensitive. Multiple values of a header are bound to
+// slice fields.
+//
+// Type casting, default values, required fields and validation errors work the same way as
+// [ParseQuery]. If options are nil, default options are used.
+func ParseHeader(header http.Header, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseHeader(header, target)
+}
+
+// parseHeader is the implementation of [Parser.ParseHeader]. opts must be non-nil.
+func parseHeader(header http.Header, target any, opts *ParseQueryOptions) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryTarget
+	}
+
+	plan, err := cachedStructPlan(v.Elem().Type(), headerSource, opts)
+	if err != nil {
+		return err
+	}
+
+	return plan.bind(headerValues(header, plan.fieldKeys()), v, headerSource, opts)
+}
+
+// headerValues returns the values of all headers keyed by their names. Headers whose names match
+// one of the field keys case-insensitively, e.g. "X-Request-Id" for the field key "x-request-id",
+// are keyed by the field key instead, so the fields are bound regardless of the case of their
+// keys.
+func headerValues(header http.Header, fieldKeys []string) map[string][]string {
+	values := make(map[string][]string, len(header))
+	for name, nameValues := range header { //nolint:wsl
+		values[name] = nameValues
+	}
+
+	for _, fieldKey := range fieldKeys {
+		name := http.CanonicalHeaderKey(fieldKey)
+		if name == fieldKey {
+			continue
+		}
+
+		if nameValues := header.Values(fieldKey); len(nameValues) > 0 {
+			values[fieldKey] = nameValues
+			delete(values, name)
+		}
+	}
+
+	return values
+}
+
+// ParseRequest parses the request into given struct. Each field is bound from the source of its
+// struct tag:
+//
+//   - `query`: query parameters of the request URL.
+//   - `path`: path parameters, see [ParseQueryOptions.PathParams].
+//   - `header`: request headers. Header names are case-insensitive.
+//   - `cookie`: values of the request cookies.
+//   - `json`: keys of the JSON object in the request body, decoded the same way as [ParseJSON].
+//
+// If a field has more than one of these tags, the first one in the order path, query, header,
+// cookie and json is used, so fields of a response struct with `json` tags can also be bound from
+// the other sources. Fields with none of the tags cause [ErrRequestTagNotFound] error; use
+// e.g. `query:"-"` to ignore a field. The body is read only if there is a `json` field, and an
+// empty body is treated as an empty JSON object.
+//
+// Validation errors of all sources are aggregated into a single [QueryValidationError]. If
+// options are nil, default options are used.
+func ParseRequest(r *http.Request, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseRequest(r, target)
+}
+
+// parseRequest is the implementation of [Parser.ParseRequest]. opts must be non-nil.
+func parseRequest( //nolint:cyclop,funlen
+	r *http.Request,
+	target any,
+	opts *ParseQueryOptions,
+) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryTarget
+	}
+
+	validationErrors := newQueryValidationError(SourceRequest, "request", opts)
+
+	structElem := v.Elem()
+	if opts.Atomic {
+		structElem = reflect.New(structElem.Type()).Elem()
+	}
+
+	values := requestValues{r: r, opts: opts}
+	bindable := false
+	boundFieldIndexes := make([]int, 0, structElem.NumField())
+
+	for i := 0; i < structElem.NumField(); i++ {
+		fieldv := structElem.Field(i)
+		structField := structElem.Type().Field(i)
+
+		source, fieldKey, ok := requestFieldSource(structField)
+
+		switch {
+		case ok && fieldKey == "-":
+			continue
+		case ok:
+			validationErrors.mutableState().fieldSource = source.source
+
+			err := bindField(
+				fieldv, structField, fieldKey, values.of(source, fieldKey), source, opts,
+				validationErrors,
+			)
+			if err != nil {
+				return err
+			}
+		case structField.Tag.Get("json") != "":
+			fieldKey = jsonFieldKey(structField)
+			if !structField.IsExported() || fieldKey == "-" {
+				continue
+			}
+
+			bindable = true
+
+			objectFields, ok, err := values.jsonObject(validationErrors)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				// The body is not a valid JSON object, which is reported as a struct error.
+				continue
+			}
+
+			validationErrors.mutableState().fieldSource = SourceBody
+
+			// Absent fields are left untouched, so they are not copied into the target either.
+			ok, err = bindJSONField(
+				fieldv, structField, fieldKey, objectFields, opts, validationErrors,
+			)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				continue
+			}
+		default:
+			return fmt.Errorf("%w: %s", ErrRequestTagNotFound, structField.Name)
+		}
+
+		bindable = true
+		boundFieldIndexes = append(boundFieldIndexes, i)
+	}
+
+	// The errors reported after binding, e.g. the `required_if` errors, have the request source.
+	validationErrors.mutableState().fieldSource = ""
+
+	return completeBinding(
+		target, structElem, bindable, boundFieldIndexes, validationErrors, opts,
+		taggedFieldKey(structElem.Type(), requestFieldKey),
+	)
+}
+
+// requestFieldSource returns the first source in [requestSources] whose tag the field has, and the
+// key of the field in that source.
+func requestFieldSource(structField reflect.StructField) (bindingSource, string, bool) {
+	for _, source := range requestSources {
+		if fieldKey, ok := structField.Tag.Lookup(source.tagName); ok {
+			return source, fieldKey, true
+		}
+	}
+
+	return bindingSource{}, "", false
+}
+
+// requestFieldKey returns the key of the struct field in its request source, see
+// [requestFieldSource]. Fields without a tag of the sources, e.g. the fields of the structs decoded
+// from JSON, use their JSON object keys.
+func requestFieldKey(structField reflect.StructField) (string, bool) {
+	if _, fieldKey, ok := requestFieldSource(structField); ok {
+		return fieldKey, fieldKey != "-"
+	}
+
+	return boundJSONFieldKey(structField)
+}
+
+// requestValues reads the values of the request sources. Values of each source are read once,
+// when they are needed for the first time.
+type requestValues struct {
+	r    *http.Request
+	opts *ParseQueryOptions
+
+	query      map[string][]string
+	pathParams map[string][]string
+	cookies    map[string][]string
+
+	objectFields map[string]json.RawMessage
+	bodyRead     bool
+}
+
+// of returns the values of the source containing the values of the field key.
+func (rv *requestValues) of(source bindingSource, fieldKey string) map[string][]string {
+	switch source.tagName {
+	case pathSource.tagName:
+		if rv.opts.PathParams == nil {
+			return requestPathValues(rv.r, []string{fieldKey})
+		}
+
+		if rv.pathParams == nil {
+			rv.pathParams = pathValues(rv.opts.PathParams(rv.r))
+		}
+
+		return rv.pathParams
+	case headerSource.tagName:
+		return headerValues(rv.r.Header, []string{fieldKey})
+	case cookieSource.tagName:
+		if rv.cookies == nil {
+			rv.cookies = make(map[string][]string)
+			for _, cookie := range rv.r.Cookies() { //nolint:wsl
+				rv.cookies[cookie.Name] = append(rv.cookies[cookie.Name], cookie.Value)
+			}
+		}
+
+		return rv.cookies
+	default:
+		if rv.query == nil {
+			rv.query = rv.r.URL.Query()
+		}
+
+		return rv.query
+	}
+}
+
+// jsonObject reads and decodes the JSON object in the request body. If the body is not a valid
+// JSON object, a struct error is added to the validation errors once and false is returned. Errors
+// reading the body are returned as is.
+func (rv *requestValues) jsonObject(
+	validationErrors *QueryValidationError,
+) (map[string]json.RawMessage, bool, error) {
+	if rv.bodyRead {
+		return rv.objectFields, rv.objectFields != nil, nil
+	}
+
+	rv.bodyRead = true
+
+	var body []byte
+
+	if rv.r.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(rv.r.Body)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if len(body) == 0 {
+		rv.objectFields = map[string]json.RawMessage{}
+		return rv.objectFields, true, nil
+	}
+
+	objectFields, structError := decodeJSONObject(body)
+	if structError != "" {
+		validationErrors.addStructError(structError)
+		return nil, false, nil
+	}
+
+	rv.objectFields = objectFields
+
+	return rv.objectFields, true, nil
+}
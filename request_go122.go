@@ -0,0 +1,12 @@
+//go:build go1.22
+
+package reqparse
+
+import "net/http"
+
+// requestPathValue returns the path parameter of the request matched by [http.ServeMux]. Empty
+// values are treated as absent.
+func requestPathValue(r *http.Request, name string) (string, bool) {
+	value := r.PathValue(name)
+	return value, value != ""
+}
@@ -0,0 +1,31 @@
+//go:build go1.22
+
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequestPathValue(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		UserID int    `path:"user_id"`
+		Tab    string `path:"tab"     default:"profile"`
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	r.SetPathValue("user_id", "42")
+
+	var s MyStruct
+	err := reqparse.ParseRequest(r, &s, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, MyStruct{UserID: 42, Tab: "profile"}, s)
+}
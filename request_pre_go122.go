@@ -0,0 +1,11 @@
+//go:build !go1.22
+
+package reqparse
+
+import "net/http"
+
+// requestPathValue always reports the path parameter as absent, since [http.Request.PathValue] is
+// not available before Go 1.22. Use [ParseQueryOptions.PathParams] instead.
+func requestPathValue(*http.Request, string) (string, bool) {
+	return "", false
+}
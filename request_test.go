@@ -0,0 +1,197 @@
+package reqparse_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequest(t *testing.T) {
+	t.Parallel()
+
+	pathParams := func(*http.Request) map[string]string {
+		return map[string]string{"user_id": "42"}
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(
+			http.MethodPatch,
+			"/users/42?dry_run=true&fields=name&fields=email",
+			strings.NewReader(`{"name": "Berk", "age": 25}`),
+		)
+		r.Header.Set("X-Request-Id", "abc")
+		r.Header.Add("Accept-Language", "tr")
+		r.Header.Add("Accept-Language", "en")
+		r.AddCookie(&http.Cookie{Name: "session", Value: "s3cr3t"})
+
+		type MyStruct struct {
+			UserID    int      `path:"user_id"`
+			DryRun    bool     `query:"dry_run"`
+			Fields    []string `query:"fields"`
+			RequestID string   `header:"x-request-id"`
+			Languages []string `header:"Accept-Language"`
+			Session   string   `cookie:"session"`
+			Theme     string   `cookie:"theme"           default:"light"`
+			Name      string   `json:"name"              required:"true"`
+			Age       *int     `json:"age"`
+			Email     *string  `json:"email"`
+			Page      int      `query:"page"             json:"page"     default:"1"`
+			Internal  string   `query:"-"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, &reqparse.ParseQueryOptions{PathParams: pathParams})
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			UserID:    42,
+			DryRun:    true,
+			Fields:    []string{"name", "email"},
+			RequestID: "abc",
+			Languages: []string{"tr", "en"},
+			Session:   "s3cr3t",
+			Theme:     "light",
+			Name:      "Berk",
+			Age:       newPointer(25),
+			Page:      1,
+		}, s)
+	})
+
+	t.Run("errors of all sources are aggregated", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(
+			http.MethodPost,
+			"/users/42?limit=many",
+			strings.NewReader(`{"age": "old"}`),
+		)
+		r.Header.Set("X-Retry", "no")
+
+		type MyStruct struct {
+			UserID  int    `path:"user_id"`
+			OrgID   int    `path:"org_id"`
+			Limit   int    `query:"limit"`
+			Retry   int    `header:"X-Retry"`
+			Session string `cookie:"session"`
+			Name    string `json:"name"      required:"true"`
+			Age     int    `json:"age"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, &reqparse.ParseQueryOptions{PathParams: pathParams})
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"org_id":  {"field is required"},
+			"limit":   {"must be a valid integer"},
+			"X-Retry": {"must be a valid integer"},
+			"session": {"field is required"},
+			"name":    {"field is required"},
+			"age":     {"must be a valid integer"},
+		}, validationError.FieldErrors)
+		assert.Equal(t, []string{
+			"org_id", "limit", "X-Retry", "session", "name", "age",
+		}, validationError.FieldErrorKeys())
+		assert.Contains(t, validationError.Error(), "Parsing request failed.")
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodPost, "/?q=a", strings.NewReader(`[1, 2]`))
+
+		type MyStruct struct {
+			Query string `query:"q"`
+			Name  string `json:"name" required:"true"`
+			Age   int    `json:"age"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(
+			t, []string{"request body must be a JSON object"}, validationError.StructErrors,
+		)
+		assert.Empty(t, validationError.FieldErrors)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		type MyStruct struct {
+			Name string `json:"name" required:"true"`
+			Age  int    `json:"age"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name": {"field is required"},
+			"age":  {"field is required"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("body is not read without json fields", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodPost, "/?q=a", strings.NewReader("not json"))
+
+		type MyStruct struct {
+			Query string `query:"q"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{Query: "a"}, s)
+	})
+
+	t.Run("tag not found", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		type MyStruct struct {
+			Name string
+		}
+
+		var s MyStruct
+		err := reqparse.ParseRequest(r, &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrRequestTagNotFound)
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		t.Parallel()
+
+		r := httptest.NewRequest(http.MethodPost, "/?q=a", strings.NewReader(`{"age": "x"}`))
+
+		type MyStruct struct {
+			Query string `query:"q"`
+			Age   int    `json:"age"`
+		}
+
+		s := MyStruct{Query: "initial"}
+		err := reqparse.ParseRequest(r, &s, &reqparse.ParseQueryOptions{Atomic: true})
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, MyStruct{Query: "initial"}, s)
+	})
+}
@@ -0,0 +1,144 @@
+package reqparse
+
+import (
+	"reflect"
+	"strings"
+)
+
+// requiredRule is a conditional requirement of a field: the field is required if the other fields
+// have the given values ("required_if") or the other field has no value ("required_without").
+type requiredRule struct {
+	field fieldRef
+
+	// conditions are the fields and their values making the field required. For
+	// "required_without", there is a single condition whose value is not used.
+	conditions []requiredCondition
+	without    bool
+}
+
+// requiredCondition is a field and the value of it making another field required.
+type requiredCondition struct {
+	field fieldRef
+	value string
+}
+
+// addRequirement adds the rule of the conditional requirement of the field, e.g.
+// "required_if=Type scheduled" or "required_without=IDs". The fields of "required_if" are
+// followed by their values, and all must match for the field to be required. It returns false if
+// the constraint is invalid.
+func (r *crossFieldRules) addRequirement(
+	structType reflect.Type,
+	structField reflect.StructField,
+	parent fieldRef,
+	field fieldRef,
+	name string,
+	arg string,
+) bool {
+	rule := requiredRule{field: field, without: name == "required_without"}
+
+	var args []string
+	if rule.without {
+		args = []string{arg, ""}
+	} else {
+		args = strings.Fields(arg)
+	}
+
+	if len(args) == 0 || len(args)%2 != 0 {
+		return false
+	}
+
+	for i := 0; i < len(args); i += 2 {
+		other, ok := structType.FieldByName(args[i])
+		if !ok || !other.IsExported() || other.Name == structField.Name {
+			return false
+		}
+
+		rule.conditions = append(rule.conditions, requiredCondition{
+			field: parent.field(structType, other.Index),
+			value: args[i+1],
+		})
+	}
+
+	r.requirements = append(r.requirements, rule)
+
+	return true
+}
+
+// check reports the "field is required" validation error for the field if it has no value and its
+// conditions are met, unless it already has a validation error.
+func (r requiredRule) check(
+	structElem reflect.Value,
+	fieldKey fieldKeyFunc,
+	validationErrors *QueryValidationError,
+) {
+	key := crossFieldKey(fieldKey, r.field.names)
+	if len(validationErrors.FieldErrors[key]) > 0 ||
+		hasValue(structElem, r.field.index, key, validationErrors) {
+		return
+	}
+
+	for _, condition := range r.conditions {
+		if r.without {
+			otherKey := crossFieldKey(fieldKey, condition.field.names)
+			if hasValue(structElem, condition.field.index, otherKey, validationErrors) {
+				return
+			}
+
+			continue
+		}
+
+		otherv, ok := fieldValueAt(structElem, condition.field.index)
+		if !ok || valueText(otherv.Interface()) != condition.value {
+			return
+		}
+	}
+
+	validationErrors.addFieldErr(key, noIndex, errRequired)
+}
+
+// hasValue reports whether the field at the index path of the struct has a value: a non-nil
+// pointer, a non-empty slice or map, or a value present in the source. Explicitly supplied zero
+// values, e.g. "count=0", are values, while the default values of the absent fields are not. If
+// the presence of the field is not recorded by the binders, e.g. a field of a struct decoded from
+// JSON as a whole, the field has a value if it is not zero.
+func hasValue(
+	structv reflect.Value,
+	index []int,
+	fieldKey string,
+	validationErrors *QueryValidationError,
+) bool {
+	v, ok := fieldValueAt(structv, index)
+	if !ok {
+		return false
+	}
+
+	if fieldKindAt(structv.Type(), index) == reflect.Pointer {
+		return true
+	}
+
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Map {
+		return v.Len() > 0
+	}
+
+	if present, recorded := validationErrors.presence(fieldKey); recorded {
+		return present
+	}
+
+	return !v.IsZero()
+}
+
+// fieldKindAt returns the kind of the field at the index path of the struct type, without
+// dereferencing the field if it is a pointer.
+func fieldKindAt(structType reflect.Type, index []int) reflect.Kind {
+	fieldType := structType
+
+	for _, i := range index {
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		fieldType = fieldType.Field(i).Type
+	}
+
+	return fieldType.Kind()
+}
@@ -0,0 +1,137 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryConditionalRequirements(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Type     string   `query:"type"     default:"instant"`
+		Priority int      `query:"priority" default:"0"`
+		SendAt   *string  `query:"send_at"  validate:"required_if=Type scheduled"`
+		Reason   *string  `query:"reason"   validate:"required_if=Type scheduled Priority 1"`
+		IDs      []int    `query:"id"       validate:"required_without=Query"`
+		Query    *string  `query:"q"`
+		Tags     []string `query:"tag"      validate:"required_without=Query,max=5"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"type":     {"scheduled"},
+			"priority": {"1"},
+			"send_at":  {"2024-01-01"},
+			"reason":   {"maintenance"},
+			"q":        {"report"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "report", *s.Query)
+		assert.Equal(t, []int{}, s.IDs)
+	})
+
+	t.Run("conditions not met", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":  {"1"},
+			"tag": {"a"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"type":     {"scheduled"},
+			"priority": {"1"},
+			"tag":      {"too long"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"send_at": {"field is required"},
+			"reason":  {"field is required"},
+			"id":      {"field is required"},
+			"tag":     {"(Index: 0) must be at most 5 characters long"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("explicit zero values", func(t *testing.T) {
+		t.Parallel()
+
+		type OtherStruct struct {
+			Type  string `query:"type"`
+			Count *int   `query:"count" validate:"required_if=Type scheduled"`
+			Limit int    `query:"limit" validate:"required_if=Type scheduled" default:"10"`
+			Flag  bool   `query:"flag"  validate:"required_if=Type scheduled" default:"true"`
+		}
+
+		inputQueryParams := map[string][]string{
+			"type":  {"scheduled"},
+			"count": {"0"},
+			"limit": {"0"},
+			"flag":  {"false"},
+		}
+
+		var s OtherStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		count := 0
+		assert.Equal(t, OtherStruct{Type: "scheduled", Count: &count}, s)
+
+		// Default values of the absent fields don't satisfy the requirements.
+		err = reqparse.ParseQuery(map[string][]string{"type": {"scheduled"}}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"count": {"field is required"},
+			"limit": {"field is required"},
+			"flag":  {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid validate tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				SendAt *string `query:"send_at" validate:"required_if=Type"`
+			}{},
+			&struct {
+				SendAt *string `query:"send_at" validate:"required_if=Type scheduled"`
+			}{},
+			&struct {
+				IDs []int `query:"id" validate:"required_without=IDs"`
+			}{},
+			&struct {
+				IDs []int `query:"id" validate:"required_without="`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
@@ -0,0 +1,285 @@
+package reqparse
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Rule is a validation rule applied to the parsed value of a field. value is the casted value of
+// the field, e.g. an int for an int field. For slice fields, the rule is applied to each element
+// and for pointer fields to the pointed value. The returned error's message is reported as a field
+// validation error.
+//
+// Rules are applied only if the field value is casted successfully.
+type Rule func(value any) error
+
+// Min returns a [Rule] that reports a validation error if a numeric value is less than n. Non
+// numeric values are always valid.
+func Min(n float64) Rule {
+	err := newCodedError(
+		CodeMin, "must be greater than or equal to "+formatFloat(n), map[string]any{"min": n},
+	)
+
+	return func(value any) error {
+		if f, ok := numericValue(value); ok && !InRange(f, n, math.Inf(1)) {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// Max returns a [Rule] that reports a validation error if a numeric value is greater than n. Non
+// numeric values are always valid.
+func Max(n float64) Rule {
+	err := newCodedError(
+		CodeMax, "must be less than or equal to "+formatFloat(n), map[string]any{"max": n},
+	)
+
+	return func(value any) error {
+		if f, ok := numericValue(value); ok && !InRange(f, math.Inf(-1), n) {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// MultipleOf returns a [Rule] that reports a validation error if a numeric value is not a multiple
+// of n, e.g. 30 for n = 10. n must be positive. Float values are compared with a small
+// tolerance, so 0.3 is a multiple of 0.1. Non numeric values are always valid.
+func MultipleOf(n float64) Rule {
+	err := newCodedError(
+		CodeMultipleOf, "must be a multiple of "+formatFloat(n), map[string]any{"multiple": n},
+	)
+
+	return func(value any) error {
+		f, ok := numericValue(value)
+		if !ok {
+			return nil
+		}
+
+		quotient := f / n
+		if math.Abs(quotient-math.Round(quotient)) > multipleOfTolerance {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// MinLength returns a [Rule] that reports a validation error if a string value has less than n
+// characters. Non string values are always valid.
+func MinLength(n int) Rule {
+	err := newCodedError(
+		CodeMinLength,
+		"must be at least "+strconv.Itoa(n)+" characters long",
+		map[string]any{"min": n},
+	)
+
+	return func(value any) error {
+		if length, ok := stringLength(value); ok && length < n {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// MaxLength returns a [Rule] that reports a validation error if a string value has more than n
+// characters. Non string values are always valid.
+func MaxLength(n int) Rule {
+	err := newCodedError(
+		CodeMaxLength,
+		"must be at most "+strconv.Itoa(n)+" characters long",
+		map[string]any{"max": n},
+	)
+
+	return func(value any) error {
+		if length, ok := stringLength(value); ok && length > n {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// Length returns a [Rule] that reports a validation error if a string value doesn't have exactly
+// n characters. Non string values are always valid.
+func Length(n int) Rule {
+	err := newCodedError(
+		CodeLength,
+		"must be exactly "+strconv.Itoa(n)+" characters long",
+		map[string]any{"length": n},
+	)
+
+	return func(value any) error {
+		if length, ok := stringLength(value); ok && length != n {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// Pattern returns a [Rule] that reports a validation error if a string value doesn't match the
+// regular expression. Non string values are always valid.
+func Pattern(re *regexp.Regexp) Rule {
+	err := newCodedError(
+		CodePattern, "does not match required pattern", map[string]any{"pattern": re.String()},
+	)
+
+	return func(value any) error {
+		v := reflect.ValueOf(value)
+		if v.Kind() == reflect.String && !re.MatchString(v.String()) {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// OneOf returns a [Rule] that reports a validation error if a value is not one of the allowed
+// values. Values are compared by their text representations, e.g. "asc" for a string value and
+// "10" for an int value. See [valueText].
+func OneOf(allowed ...string) Rule {
+	err := newCodedError(
+		CodeOneOf,
+		"must be one of: "+strings.Join(allowed, ", "),
+		map[string]any{"allowed": allowed},
+	)
+
+	return func(value any) error {
+		s := valueText(value)
+
+		for _, a := range allowed {
+			if s == a {
+				return nil
+			}
+		}
+
+		return err
+	}
+}
+
+// valueText returns the text representation of a casted value: the text of the values implementing
+// [encoding.TextMarshaler], the underlying string of the string kinds (ignoring their String
+// methods, e.g. of enum types), or the value formatted with [fmt.Sprint].
+func valueText(value any) string {
+	if marshaler, ok := value.(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+
+	if v := reflect.ValueOf(value); v.Kind() == reflect.String {
+		return v.String()
+	}
+
+	return fmt.Sprint(value)
+}
+
+// presetRules returns the rules of the presets referenced by the `preset` tag of the field. Use
+// comma separated preset names to reference multiple presets.
+func presetRules(
+	presetNames []string,
+	fieldName string,
+	presets map[string][]Rule,
+) ([]Rule, error) {
+	var rules []Rule
+
+	for _, presetName := range presetNames {
+		presetRules, ok := presets[presetName]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s (%s)", ErrUnknownPreset, presetName, fieldName)
+		}
+
+		rules = append(rules, presetRules...)
+	}
+
+	return rules, nil
+}
+
+// applyRules applies the rules to the field value and records the validation errors. Slice rule
+// errors are prefixed with the index of the element.
+func applyRules(
+	fieldv reflect.Value,
+	rules []Rule,
+	fieldKey string,
+	validationErrors *QueryValidationError,
+) {
+	if len(rules) == 0 {
+		return
+	}
+
+	switch fieldv.Kind() { //nolint:exhaustive
+	case reflect.Slice:
+		for i := 0; i < fieldv.Len(); i++ {
+			element := fieldv.Index(i)
+			if element.Kind() == reflect.Pointer {
+				// Rules are not applied to the nil elements of the slices of pointers.
+				if element.IsNil() {
+					continue
+				}
+
+				element = element.Elem()
+			}
+
+			for _, rule := range rules {
+				if err := rule(element.Interface()); err != nil {
+					validationErrors.addFieldErr(fieldKey, i, err)
+				}
+			}
+		}
+
+	case reflect.Pointer:
+		if fieldv.IsNil() {
+			return
+		}
+
+		applyRules(fieldv.Elem(), rules, fieldKey, validationErrors)
+
+	default:
+		for _, rule := range rules {
+			if err := rule(fieldv.Interface()); err != nil {
+				validationErrors.addFieldErr(fieldKey, noIndex, err)
+			}
+		}
+	}
+}
+
+// numericValue returns the value as float64 if it is a number.
+func numericValue(value any) (float64, bool) {
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// stringLength returns the number of characters of the value if it is a string.
+func stringLength(value any) (int, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.String {
+		return 0, false
+	}
+
+	return utf8.RuneCountInString(v.String()), true
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,87 @@
+package reqparse_test
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMin(t *testing.T) {
+	t.Parallel()
+
+	rule := reqparse.Min(1.5)
+
+	require.NoError(t, rule(2))
+	require.NoError(t, rule(1.5))
+	require.NoError(t, rule("0"))
+	require.EqualError(t, rule(1), "must be greater than or equal to 1.5")
+	require.EqualError(t, rule(-3.2), "must be greater than or equal to 1.5")
+	require.EqualError(t, rule(math.NaN()), "must be greater than or equal to 1.5")
+}
+
+func TestMax(t *testing.T) {
+	t.Parallel()
+
+	rule := reqparse.Max(100)
+
+	require.NoError(t, rule(100))
+	require.NoError(t, rule(-5.5))
+	require.NoError(t, rule(true))
+	require.EqualError(t, rule(101), "must be less than or equal to 100")
+	require.EqualError(t, rule(math.NaN()), "must be less than or equal to 100")
+	assert.EqualError(t, rule(100.01), "must be less than or equal to 100")
+}
+
+func TestMultipleOf(t *testing.T) {
+	t.Parallel()
+
+	rule := reqparse.MultipleOf(10)
+
+	require.NoError(t, rule(30))
+	require.NoError(t, rule(uint8(0)))
+	require.NoError(t, rule(-20.0))
+	require.NoError(t, rule("15"))
+	require.EqualError(t, rule(15), "must be a multiple of 10")
+	require.NoError(t, reqparse.MultipleOf(0.1)(0.3))
+	assert.EqualError(t, reqparse.MultipleOf(0.25)(0.3), "must be a multiple of 0.25")
+}
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+
+	rule := reqparse.OneOf("asc", "desc", "10")
+
+	require.NoError(t, rule("asc"))
+	require.NoError(t, rule("desc"))
+	require.NoError(t, rule(10))
+	require.EqualError(t, rule("ASC"), "must be one of: asc, desc, 10")
+	assert.EqualError(t, rule(10.5), "must be one of: asc, desc, 10")
+}
+
+func TestLengthRules(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, reqparse.MinLength(2)("ab"))
+	require.NoError(t, reqparse.MinLength(2)(1))
+	require.EqualError(t, reqparse.MinLength(2)("ç"), "must be at least 2 characters long")
+
+	require.NoError(t, reqparse.MaxLength(2)("çç"))
+	require.EqualError(t, reqparse.MaxLength(2)("abc"), "must be at most 2 characters long")
+
+	require.NoError(t, reqparse.Length(3)("abc"))
+	assert.EqualError(t, reqparse.Length(3)("ab"), "must be exactly 3 characters long")
+}
+
+func TestPattern(t *testing.T) {
+	t.Parallel()
+
+	rule := reqparse.Pattern(regexp.MustCompile(`^[a-z]+$`))
+
+	require.NoError(t, rule("abc"))
+	require.NoError(t, rule(10))
+	assert.EqualError(t, rule("ABC"), "does not match required pattern")
+}
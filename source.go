@@ -0,0 +1,25 @@
+package reqparse
+
+// Source is the part of the request the values of a [ValidationError] are parsed from.
+type Source string
+
+// Sources of the validation errors.
+const (
+	SourceQuery  Source = "query"
+	SourcePath   Source = "path"
+	SourceHeader Source = "header"
+	SourceCookie Source = "cookie"
+
+	// SourceBody is the source of the values of the request body, e.g. a JSON object or a form.
+	SourceBody Source = "body"
+
+	// SourceRequest is the source of the errors of [ParseRequest], whose fields are parsed from
+	// multiple sources. The source of each field error is in [FieldError].Source.
+	SourceRequest Source = "request"
+)
+
+// ValidationError is the validation error returned by all parsers of the package, regardless of
+// the source of the values, e.g. [ParseQuery], [ParseHeader] or [ParseBody]. Its Source field
+// reports where the invalid values come from. It is the same type as [QueryValidationError], so
+// both names can be used with [errors.As].
+type ValidationError = QueryValidationError
@@ -0,0 +1,114 @@
+package reqparse_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationErrorSource(t *testing.T) {
+	t.Parallel()
+
+	type QueryParams struct {
+		Page int `query:"page"`
+	}
+
+	type PathParams struct {
+		ID int `path:"id"`
+	}
+
+	type Headers struct {
+		Limit int `header:"X-Limit"`
+	}
+
+	type Body struct {
+		Count int `json:"count"`
+	}
+
+	testCases := map[string]struct {
+		parse          func() error
+		expectedSource reqparse.Source
+	}{
+		"query": {
+			parse: func() error {
+				return reqparse.ParseQuery(map[string][]string{"page": {"x"}}, &QueryParams{}, nil)
+			},
+			expectedSource: reqparse.SourceQuery,
+		},
+		"path": {
+			parse: func() error {
+				return reqparse.ParsePath(map[string]string{"id": "x"}, &PathParams{}, nil)
+			},
+			expectedSource: reqparse.SourcePath,
+		},
+		"header": {
+			parse: func() error {
+				return reqparse.ParseHeader(http.Header{"X-Limit": {"x"}}, &Headers{}, nil)
+			},
+			expectedSource: reqparse.SourceHeader,
+		},
+		"body": {
+			parse: func() error {
+				return reqparse.ParseJSON(strings.NewReader(`{"count": "x"}`), &Body{}, nil)
+			},
+			expectedSource: reqparse.SourceBody,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var validationErr *reqparse.ValidationError
+			require.ErrorAs(t, tc.parse(), &validationErr)
+			assert.Equal(t, tc.expectedSource, validationErr.Source)
+
+			fieldErrors := validationErr.FieldErrorList()
+			require.Len(t, fieldErrors, 1)
+			assert.Equal(t, tc.expectedSource, fieldErrors[0].Source)
+		})
+	}
+
+	t.Run("request", func(t *testing.T) {
+		t.Parallel()
+
+		type Request struct {
+			Page   int     `query:"page"`
+			Limit  int     `header:"X-Limit"`
+			Count  int     `json:"count"`
+			Reason *string `query:"reason" validate:"required_if=Page 1"`
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/?page=1", strings.NewReader(`{"count": "x"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-Limit", "x")
+
+		err := reqparse.ParseRequest(r, &Request{}, nil)
+
+		var validationErr *reqparse.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, reqparse.SourceRequest, validationErr.Source)
+
+		sources := make(map[string]reqparse.Source)
+		for _, fieldErr := range validationErr.FieldErrorList() { //nolint:wsl
+			sources[fieldErr.Field] = fieldErr.Source
+		}
+
+		assert.Equal(t, map[string]reqparse.Source{
+			"X-Limit": reqparse.SourceHeader,
+			"count":   reqparse.SourceBody,
+			"reason":  reqparse.SourceRequest,
+		}, sources)
+
+		var queryValidationErr *reqparse.QueryValidationError
+		assert.True(t, errors.As(err, &queryValidationErr))
+	})
+}
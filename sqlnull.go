@@ -0,0 +1,35 @@
+package reqparse
+
+import (
+	"reflect"
+	"strings"
+)
+
+// isSQLNullType reports whether the type is a nullable type of database/sql, e.g. sql.NullString
+// or sql.Null[T]. These types are structs of the value and the Valid field. Fields of these types
+// are optional: their Valid field is false if the value is not present in the source.
+func isSQLNullType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == "database/sql" &&
+		strings.HasPrefix(t.Name(), "Null") && t.NumField() == 2 &&
+		t.Field(1).Name == "Valid" && t.Field(1).Type.Kind() == reflect.Bool
+}
+
+// castSQLNullValue casts the value into the value field of the nullable database/sql type and
+// marks it valid.
+func castSQLNullValue(
+	targetType reflect.Type,
+	value string,
+	opts castOptions,
+) (reflect.Value, error) {
+	castedValue := reflect.New(targetType).Elem()
+
+	v, err := castQueryValue(targetType.Field(0).Type, value, opts)
+	if err != nil {
+		return castedValue, err
+	}
+
+	castedValue.Field(0).Set(v)
+	castedValue.Field(1).SetBool(true)
+
+	return castedValue, nil
+}
@@ -0,0 +1,117 @@
+package reqparse_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuerySQLNullTypes(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Name     sql.NullString   `query:"name"`
+		Age      sql.NullInt64    `query:"age"`
+		Active   sql.NullBool     `query:"active"`
+		MinPrice sql.NullFloat64  `query:"min_price" stripsuffix:"USD"`
+		Since    sql.NullTime     `query:"since"     layout:"2006-01-02"`
+		Limit    sql.NullInt32    `query:"limit"     default:"20"`
+		Cursor   sql.NullString   `query:"cursor"    default:""`
+		Tags     []sql.NullString `query:"tag"`
+	}
+
+	t.Run("present values", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"name":      {""},
+			"age":       {"42"},
+			"active":    {"false"},
+			"min_price": {"9.5USD"},
+			"since":     {"2024-01-02"},
+			"limit":     {"5"},
+			"cursor":    {"abc"},
+			"tag":       {"a", "b"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Name:     sql.NullString{String: "", Valid: true},
+			Age:      sql.NullInt64{Int64: 42, Valid: true},
+			Active:   sql.NullBool{Bool: false, Valid: true},
+			MinPrice: sql.NullFloat64{Float64: 9.5, Valid: true},
+			Since:    sql.NullTime{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+			Limit:    sql.NullInt32{Int32: 5, Valid: true},
+			Cursor:   sql.NullString{String: "abc", Valid: true},
+			Tags: []sql.NullString{
+				{String: "a", Valid: true},
+				{String: "b", Valid: true},
+			},
+		}, s)
+	})
+
+	t.Run("not present values", func(t *testing.T) {
+		t.Parallel()
+
+		s := MyStruct{
+			Name:   sql.NullString{String: "stale", Valid: true},
+			Cursor: sql.NullString{String: "stale", Valid: true},
+		}
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Limit: sql.NullInt32{Int32: 20, Valid: true},
+			Tags:  []sql.NullString{},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"age":       {"old"},
+			"active":    {"maybe"},
+			"min_price": {"cheap"},
+			"since":     {"yesterday"},
+			"limit":     {"3000000000"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"age":       {"must be a valid integer"},
+			"active":    {"must be a valid boolean"},
+			"min_price": {"must be a valid float"},
+			"since":     {"must be a valid date"},
+			"limit":     {"must be between -2147483648 and 2147483647"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("required field", func(t *testing.T) {
+		t.Parallel()
+
+		type RequiredStruct struct {
+			Name sql.NullString `query:"name" required:"true"`
+		}
+
+		var s RequiredStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"name": {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+}
@@ -0,0 +1,36 @@
+package reqparse
+
+import "reflect"
+
+// QueryStructValidator is implemented by the target structs that validate the relations between
+// their fields, e.g. a start date before an end date. Validate is called on the target after all
+// fields are bound without validation errors, and the messages of the returned errors are
+// reported as [QueryValidationError.StructErrors].
+type QueryStructValidator interface {
+	Validate() []error
+}
+
+// runStructValidators calls the Validate method of the struct if it implements
+// [QueryStructValidator] and [ParseQueryOptions.StructValidators], and adds their errors to the
+// struct errors. structElem must be addressable.
+func runStructValidators(
+	structElem reflect.Value,
+	validationErrors *QueryValidationError,
+	structValidators []func(target any) []string,
+) {
+	target := structElem.Addr().Interface()
+
+	if validator, ok := target.(QueryStructValidator); ok {
+		for _, err := range validator.Validate() {
+			if err != nil {
+				validationErrors.addStructError(err.Error())
+			}
+		}
+	}
+
+	for _, validate := range structValidators {
+		for _, message := range validate(target) {
+			validationErrors.addStructError(message)
+		}
+	}
+}
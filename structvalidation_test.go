@@ -0,0 +1,103 @@
+package reqparse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type dateRange struct {
+	From  time.Time `query:"from"  timeformat:"date"`
+	To    time.Time `query:"to"    timeformat:"date"`
+	Limit int       `query:"limit" default:"10"`
+}
+
+func (r *dateRange) Validate() []error {
+	if r.To.Before(r.From) {
+		return []error{errors.New("from must be before to")}
+	}
+
+	return nil
+}
+
+func TestParseQueryStructValidation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		var s dateRange
+		err := reqparse.ParseQuery(
+			map[string][]string{"from": {"2024-01-01"}, "to": {"2024-01-31"}}, &s, nil,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), s.To)
+	})
+
+	t.Run("struct errors", func(t *testing.T) {
+		t.Parallel()
+
+		opts := &reqparse.ParseQueryOptions{
+			StructValidators: []func(target any) []string{
+				func(target any) []string {
+					r, _ := target.(*dateRange)
+					if r.Limit > 5 && r.To.Sub(r.From) > 7*24*time.Hour {
+						return []string{"limit must be at most 5 for ranges longer than a week"}
+					}
+
+					return nil
+				},
+			},
+			Atomic: true,
+		}
+
+		var s dateRange
+		err := reqparse.ParseQuery(
+			map[string][]string{"from": {"2024-02-01"}, "to": {"2024-01-01"}}, &s, opts,
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"from must be before to"}, validationErr.StructErrors)
+		assert.Empty(t, validationErr.FieldErrors)
+		assert.Equal(t, dateRange{}, s)
+
+		err = reqparse.ParseQuery(
+			map[string][]string{"from": {"2024-01-01"}, "to": {"2024-02-01"}}, &s, opts,
+		)
+
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{
+			"limit must be at most 5 for ranges longer than a week",
+		}, validationErr.StructErrors)
+	})
+
+	t.Run("not called with field errors", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		opts := &reqparse.ParseQueryOptions{
+			StructValidators: []func(target any) []string{
+				func(any) []string {
+					called = true
+					return []string{"unexpected"}
+				},
+			},
+		}
+
+		var s dateRange
+		err := reqparse.ParseQuery(
+			map[string][]string{"from": {"2024-02-01"}, "to": {"x"}}, &s, opts,
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Empty(t, validationErr.StructErrors)
+		assert.False(t, called)
+	})
+}
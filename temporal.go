@@ -0,0 +1,128 @@
+package reqparse
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Units of the offsets of the relative times of the "after" and "before" constraints that aren't
+// supported by [time.ParseDuration].
+const (
+	dayDuration  = 24 * time.Hour
+	weekDuration = 7 * dayDuration
+)
+
+// timeBound is the bound of an "after" or "before" constraint: either a fixed time or a time
+// relative to the current time, e.g. "now+30d".
+type timeBound struct {
+	fixed    time.Time
+	relative bool
+	offset   time.Duration
+}
+
+// at returns the bound for the current time.
+func (b timeBound) at(now time.Time) time.Time {
+	if b.relative {
+		return now.Add(b.offset)
+	}
+
+	return b.fixed
+}
+
+// parseTimeBound parses the argument of an "after" or "before" constraint: "now", optionally
+// followed by a signed offset, e.g. "now+30d" or "now-1h30m", a date, e.g. "2024-01-31", or a time
+// in RFC 3339 format. Offsets are written like [time.ParseDuration] with the additional "d" (day)
+// and "w" (week) units, which can't be combined with the other units.
+func parseTimeBound(arg string) (timeBound, bool) {
+	if strings.HasPrefix(arg, "now") {
+		rest := arg[len("now"):]
+		if rest == "" {
+			return timeBound{relative: true}, true
+		}
+
+		if rest[0] != '+' && rest[0] != '-' {
+			return timeBound{}, false
+		}
+
+		offset, ok := parseTimeOffset(rest[1:])
+		if !ok {
+			return timeBound{}, false
+		}
+
+		if rest[0] == '-' {
+			offset = -offset
+		}
+
+		return timeBound{relative: true, offset: offset}, true
+	}
+
+	for _, layout := range []string{timeFormats["date"], time.RFC3339Nano} {
+		if t, err := time.Parse(layout, arg); err == nil {
+			return timeBound{fixed: t}, true
+		}
+	}
+
+	return timeBound{}, false
+}
+
+// parseTimeOffset parses the unsigned offset of a relative time, e.g. "30d", "2w" or "1h30m".
+func parseTimeOffset(s string) (time.Duration, bool) {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return 0, false
+	}
+
+	var unit time.Duration
+
+	switch s[len(s)-1] {
+	case 'd':
+		unit = dayDuration
+	case 'w':
+		unit = weekDuration
+	default:
+		offset, err := time.ParseDuration(s)
+		return offset, err == nil
+	}
+
+	n, err := strconv.ParseUint(s[:len(s)-1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return time.Duration(n) * unit, true
+}
+
+// timeBoundConstraint creates the rule of the "after" or "before" constraint of the `validate`
+// tag for [time.Time] values. Relative bounds are resolved when the rule is applied, so
+// "after=now" rejects the times that are past at parse time. The bounds are exclusive.
+func timeBoundConstraint(after bool) validateConstraint {
+	code, relation := CodeBefore, "before"
+	if after {
+		code, relation = CodeAfter, "after"
+	}
+
+	return func(arg string, valueType reflect.Type) (Rule, bool) {
+		bound, ok := parseTimeBound(arg)
+		if !ok || valueType != timeType {
+			return nil, false
+		}
+
+		err := newCodedError(code, "must be "+relation+" "+arg, map[string]any{"time": arg})
+
+		return func(value any) error {
+			t, ok := value.(time.Time)
+			if !ok {
+				return nil
+			}
+
+			boundTime := bound.at(time.Now())
+
+			if (after && !t.After(boundTime)) || (!after && !t.Before(boundTime)) {
+				return err
+			}
+
+			return nil
+		}, true
+	}
+}
@@ -0,0 +1,93 @@
+package reqparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryTemporalConstraints(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		CheckIn  time.Time   `query:"check_in"  validate:"after=now,before=now+30d"`
+		From     *time.Time  `query:"from"      timeformat:"date" validate:"after=2020-01-01"`
+		Days     []time.Time `query:"day"       timeformat:"date" validate:"before=2030-01-01"`
+		Reported *time.Time  `query:"reported"  validate:"before=now-1h30m"`
+	}
+
+	now := time.Now()
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"check_in": {now.Add(48 * time.Hour).Format(time.RFC3339)},
+			"from":     {"2020-01-02"},
+			"day":      {"2029-12-31"},
+			"reported": {now.Add(-2 * time.Hour).Format(time.RFC3339)},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"check_in": {now.Add(31 * 24 * time.Hour).Format(time.RFC3339)},
+			"from":     {"2020-01-01"},
+			"day":      {"2029-12-31", "2030-01-01"},
+			"reported": {now.Add(-time.Hour).Format(time.RFC3339)},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"check_in": {"must be before now+30d"},
+			"from":     {"must be after 2020-01-01"},
+			"day":      {"(Index: 1) must be before 2030-01-01"},
+			"reported": {"must be before now-1h30m"},
+		}, validationErr.FieldErrors)
+
+		inputQueryParams["check_in"] = []string{now.Add(-time.Minute).Format(time.RFC3339)}
+		err = reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"must be after now"}, validationErr.FieldErrors["check_in"])
+	})
+
+	t.Run("invalid validate tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				Name string `query:"name" validate:"after=now"`
+			}{},
+			&struct {
+				At time.Time `query:"at" validate:"after=tomorrow"`
+			}{},
+			&struct {
+				At time.Time `query:"at" validate:"before=now+"`
+			}{},
+			&struct {
+				At time.Time `query:"at" validate:"before=now+1y"`
+			}{},
+			&struct {
+				At time.Time `query:"at" validate:"before=now*2d"`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package reqparse_test
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// status is an enum type implementing encoding.TextUnmarshaler.
+type status int
+
+const (
+	statusActive status = iota + 1
+	statusArchived
+)
+
+func (s *status) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "active":
+		*s = statusActive
+	case "archived":
+		*s = statusArchived
+	default:
+		return errors.New("unknown status")
+	}
+
+	return nil
+}
+
+func TestParseQueryTextUnmarshaler(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Status   status       `query:"status"   default:"active"`
+		Statuses []status     `query:"statuses"`
+		Addr     netip.Addr   `query:"addr"`
+		Addrs    []netip.Addr `query:"addrs"    unique:"true"`
+		Gateway  *netip.Addr  `query:"gateway"`
+		IP       net.IP       `query:"ip"`
+		IPs      []net.IP     `query:"ips"      unique:"true"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"statuses": {"archived", "active"},
+			"addr":     {"10.0.0.1"},
+			"addrs":    {"::1", "10.0.0.2"},
+			"ip":       {"192.168.1.1"},
+			"ips":      {"10.0.0.1", "10.0.0.2"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			Status:   statusActive,
+			Statuses: []status{statusArchived, statusActive},
+			Addr:     netip.MustParseAddr("10.0.0.1"),
+			Addrs:    []netip.Addr{netip.MustParseAddr("::1"), netip.MustParseAddr("10.0.0.2")},
+			IP:       net.ParseIP("192.168.1.1"),
+			IPs:      []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("10.0.0.2")},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"status":   {"deleted"},
+			"statuses": {"active", "x"},
+			"addrs":    {"10.0.0.2", "10.0.0.2"},
+			"gateway":  {"10.0.0.256"},
+			"ip":       {"192.168.1"},
+			"ips":      {"10.0.0.1", "10.0.0.1"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"status":   {"must be a valid value"},
+			"statuses": {"(Index: 1) must be a valid value"},
+			"addr":     {"field is required"},
+			"addrs":    {"values must be unique"},
+			"gateway":  {"must be a valid IP address"},
+			"ip":       {"must be a valid IP address"},
+			"ips":      {"values must be unique"},
+		}, validationError.FieldErrors)
+	})
+}
@@ -0,0 +1,101 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// errNotUnique is the validation error of the slice fields with duplicate values.
+var errNotUnique = newCodedError( //nolint:gochecknoglobals
+	CodeNotUnique, "values must be unique", nil,
+)
+
+// uniqueMode is the handling of the duplicate values of a slice field.
+type uniqueMode string
+
+const (
+	// uniqueNone allows duplicate values.
+	uniqueNone uniqueMode = ""
+
+	// uniqueError reports a validation error for duplicate values.
+	uniqueError uniqueMode = "error"
+
+	// uniqueDedupe removes the duplicate values, keeping the first occurrence of each value.
+	uniqueDedupe uniqueMode = "dedupe"
+)
+
+// uniqueModeFromTags returns the unique mode selected by the `unique` tag, e.g. `unique:"true"` or
+// `unique:"dedupe"`, or the "unique" constraint of the `validate` tag, e.g. `validate:"unique"` or
+// `validate:"unique=dedupe"`. The constraint is allowed only on slice fields, while the `unique`
+// tag is ignored on the other fields. ignoreValidateTag is true if the `validate` tag is left to
+// [ParseQueryOptions.Validator].
+func uniqueModeFromTags(
+	structField reflect.StructField,
+	isSlice bool,
+	ignoreValidateTag bool,
+) (uniqueMode, error) {
+	mode := uniqueNone
+
+	switch tag := structField.Tag.Get("unique"); tag {
+	case "true":
+		mode = uniqueError
+	case string(uniqueDedupe):
+		mode = uniqueDedupe
+	}
+
+	if ignoreValidateTag {
+		return mode, nil
+	}
+
+	for _, constraint := range splitValidateTag(structField.Tag.Get("validate")) {
+		var constraintMode uniqueMode
+
+		switch constraint {
+		case "unique":
+			constraintMode = uniqueError
+		case "unique=" + string(uniqueDedupe):
+			constraintMode = uniqueDedupe
+		default:
+			continue
+		}
+
+		if !isSlice || (mode != uniqueNone && mode != constraintMode) {
+			return uniqueNone, fmt.Errorf(
+				"%w: validate:%q (%s)", ErrInvalidTag, constraint, structField.Name,
+			)
+		}
+
+		mode = constraintMode
+	}
+
+	return mode, nil
+}
+
+// removeDuplicateElements returns the slice without the duplicate elements, keeping the first
+// occurrence of each element. Elements are compared like [hasDuplicateElements].
+func removeDuplicateElements(slice reflect.Value) reflect.Value {
+	if !hasDuplicateElements(slice) {
+		return slice
+	}
+
+	result := reflect.MakeSlice(slice.Type(), 0, slice.Len())
+
+	for i := 0; i < slice.Len(); i++ {
+		element := slice.Index(i)
+
+		duplicate := false
+
+		for j := 0; j < result.Len(); j++ {
+			if reflect.DeepEqual(element.Interface(), result.Index(j).Interface()) {
+				duplicate = true
+				break
+			}
+		}
+
+		if !duplicate {
+			result = reflect.Append(result, element)
+		}
+	}
+
+	return result
+}
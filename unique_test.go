@@ -0,0 +1,85 @@
+package reqparse_test
+
+import (
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryUniqueModes(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		IDs    []int     `query:"id"     unique:"dedupe"          validate:"maxitems=2"`
+		Tags   *[]string `query:"tag"    validate:"unique=dedupe"`
+		Roles  []string  `query:"role"   validate:"unique"`
+		Scopes []*string `query:"scope"  unique:"true"            validate:"unique"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":    {"1", "01", "2", "1"},
+			"tag":   {"go", "api", "go"},
+			"role":  {"admin", "user"},
+			"scope": {"read"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		read := "read"
+		assert.Equal(t, MyStruct{
+			IDs:    []int{1, 2},
+			Tags:   &[]string{"go", "api"},
+			Roles:  []string{"admin", "user"},
+			Scopes: []*string{&read},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":    {"1", "2", "3", "3"},
+			"role":  {"admin", "admin"},
+			"scope": {"read", "read"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"id":    {"must have at most 2 values"},
+			"role":  {"values must be unique"},
+			"scope": {"values must be unique"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid validate tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				ID int `query:"id" validate:"unique"`
+			}{},
+			&struct {
+				IDs []int `query:"id" validate:"unique=true"`
+			}{},
+			&struct {
+				IDs []int `query:"id" unique:"true" validate:"unique=dedupe"`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package reqparse
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+var errInvalidURL = newCodedError( //nolint:gochecknoglobals
+	CodeInvalidURL, "must be a valid URL", nil,
+)
+
+var urlType = reflect.TypeOf(url.URL{}) //nolint:gochecknoglobals
+
+// URL kinds accepted by the `url` tag.
+const (
+	// urlKindAbsolute accepts only absolute URLs, i.e. URLs with a scheme.
+	urlKindAbsolute = "absolute"
+
+	// urlKindRelative accepts only relative references without a scheme and a host, e.g.
+	// "/orders?page=2". Network-path references like "//example.com" are rejected.
+	urlKindRelative = "relative"
+)
+
+// urlKindFromTag returns the URL kind in the `url` tag of the field. It is empty if there is no
+// such tag, which accepts both absolute and relative URLs.
+func urlKindFromTag(structField reflect.StructField) (string, error) {
+	kind, ok := structField.Tag.Lookup("url")
+	if !ok {
+		return "", nil
+	}
+
+	switch strings.ToLower(kind) {
+	case urlKindAbsolute:
+		return urlKindAbsolute, nil
+	case urlKindRelative:
+		return urlKindRelative, nil
+	default:
+		return "", fmt.Errorf("%w: url:%q (%s)", ErrInvalidTag, kind, structField.Name)
+	}
+}
+
+// parseURL parses the value as a URL of the given kind. Empty values and URLs of the other kind
+// are invalid.
+func parseURL(value string, kind string) (url.URL, error) {
+	if value == "" {
+		return url.URL{}, errInvalidURL
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		return url.URL{}, errInvalidURL
+	}
+
+	switch kind {
+	case urlKindAbsolute:
+		if !u.IsAbs() {
+			return url.URL{}, errInvalidURL
+		}
+	case urlKindRelative:
+		if u.IsAbs() || u.Host != "" {
+			return url.URL{}, errInvalidURL
+		}
+	}
+
+	return *u, nil
+}
@@ -0,0 +1,101 @@
+package reqparse_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryURL(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Callback *url.URL  `query:"callback_url" url:"absolute"`
+		Next     url.URL   `query:"next"         url:"relative" default:"/"`
+		Links    []url.URL `query:"link"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"callback_url": {"https://example.com/hooks?id=1"},
+			"link":         {"https://example.com", "/docs#intro", "mailto:admin@example.com"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		require.NotNil(t, s.Callback)
+		assert.Equal(t, "https://example.com/hooks?id=1", s.Callback.String())
+		assert.Equal(t, "example.com", s.Callback.Host)
+		assert.Equal(t, "/", s.Next.String())
+		require.Len(t, s.Links, 3)
+		assert.Equal(t, "intro", s.Links[1].Fragment)
+		assert.Equal(t, "mailto", s.Links[2].Scheme)
+	})
+
+	t.Run("optional field", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, s.Callback)
+		assert.Empty(t, s.Links)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"callback_url": {"/hooks"},
+			"next":         {"//evil.example.com/login"},
+			"link":         {"https://example.com", "", "http://[::1"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"callback_url": {"must be a valid URL"},
+			"next":         {"must be a valid URL"},
+			"link": {
+				"(Index: 1) must be a valid URL",
+				"(Index: 2) must be a valid URL",
+			},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("absolute url in relative field", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"next": {"https://example.com"}}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"next": {"must be a valid URL"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid url tag", func(t *testing.T) {
+		t.Parallel()
+
+		type InvalidStruct struct {
+			Callback *url.URL `query:"callback_url" url:"remote"`
+		}
+
+		var s InvalidStruct
+		err := reqparse.ParseQuery(map[string][]string{}, &s, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
@@ -0,0 +1,98 @@
+package reqparse
+
+import (
+	"encoding/hex"
+	"reflect"
+	"strings"
+)
+
+var errInvalidUUID = newCodedError( //nolint:gochecknoglobals
+	CodeInvalidUUID, "must be a valid UUID", nil,
+)
+
+// UUID is a universally unique identifier as defined in RFC 9562. It can be used as the type of a
+// field, e.g. `query:"id"`; values are parsed with [ParseUUID] and invalid values are reported with
+// "must be a valid UUID" validation error.
+//
+// Other UUID types that are 16 byte arrays implementing [encoding.TextUnmarshaler], e.g. the UUID
+// type of github.com/google/uuid, are reported with the same validation error.
+type UUID [16]byte
+
+// ParseUUID parses a UUID in the canonical form, e.g. "f81d4fae-7dec-11d0-a765-00a0c91e6bf6".
+// Letters are case-insensitive and the hyphens can be omitted. The form wrapped in braces and the
+// form with "urn:uuid:" prefix are also accepted. It returns [ErrInvalidUUID] if the value is
+// malformed.
+func ParseUUID(value string) (UUID, error) {
+	var u UUID
+
+	switch {
+	case len(value) == 38 && value[0] == '{' && value[37] == '}':
+		value = value[1:37]
+	case len(value) == 45 && strings.EqualFold(value[:9], "urn:uuid:"):
+		value = value[9:]
+	}
+
+	switch len(value) {
+	case 32:
+	case 36:
+		if value[8] != '-' || value[13] != '-' || value[18] != '-' || value[23] != '-' {
+			return u, ErrInvalidUUID
+		}
+
+		value = value[:8] + value[9:13] + value[14:18] + value[19:23] + value[24:]
+	default:
+		return u, ErrInvalidUUID
+	}
+
+	if _, err := hex.Decode(u[:], []byte(value)); err != nil {
+		return UUID{}, ErrInvalidUUID
+	}
+
+	return u, nil
+}
+
+// String returns the canonical form of the UUID with lowercase letters.
+func (u UUID) String() string {
+	var buf [36]byte
+
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+
+	return string(buf[:])
+}
+
+// IsZero reports whether the UUID is the nil UUID, i.e. all of its bits are zero.
+func (u UUID) IsZero() bool {
+	return u == UUID{}
+}
+
+// MarshalText implements [encoding.TextMarshaler] with the canonical form of the UUID.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] with [ParseUUID].
+func (u *UUID) UnmarshalText(text []byte) error {
+	parsed, err := ParseUUID(string(text))
+	if err != nil {
+		return err
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+// isUUIDType reports whether the type is a UUID type, i.e. a 16 byte array type whose values are
+// casted with its [encoding.TextUnmarshaler] implementation.
+func isUUIDType(t reflect.Type) bool {
+	return t.Kind() == reflect.Array && t.Len() == 16 && t.Elem().Kind() == reflect.Uint8 &&
+		isTextUnmarshalerType(t)
+}
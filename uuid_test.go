@@ -0,0 +1,186 @@
+package reqparse_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// externalUUID mimics the UUID types of other packages, e.g. github.com/google/uuid.
+type externalUUID [16]byte
+
+func (u *externalUUID) UnmarshalText(text []byte) error {
+	value := strings.ReplaceAll(string(text), "-", "")
+	if len(value) != 32 {
+		return errors.New("invalid length")
+	}
+
+	_, err := hex.Decode(u[:], []byte(value))
+
+	return err
+}
+
+func TestParseUUID(t *testing.T) {
+	t.Parallel()
+
+	want := reqparse.UUID{
+		0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0,
+		0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6,
+	}
+
+	validValues := []string{
+		"f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+		"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6",
+		"f81d4fae7dec11d0a76500a0c91e6bf6",
+		"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}",
+		"urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+	}
+
+	for _, value := range validValues {
+		value := value
+
+		t.Run(value, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := reqparse.ParseUUID(value)
+			require.NoError(t, err)
+			assert.Equal(t, want, u)
+			assert.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", u.String())
+		})
+	}
+
+	invalidValues := []string{
+		"",
+		"f81d4fae-7dec-11d0-a765-00a0c91e6bf",
+		"f81d4fae-7dec-11d0-a765-00a0c91e6bfg",
+		"f81d4fae7dec-11d0-a765-00a0c91e6bf6-",
+		"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+		"uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+	}
+
+	for _, value := range invalidValues {
+		value := value
+
+		t.Run("invalid "+value, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := reqparse.ParseUUID(value)
+			assert.ErrorIs(t, err, reqparse.ErrInvalidUUID)
+		})
+	}
+}
+
+func TestUUIDText(t *testing.T) {
+	t.Parallel()
+
+	u, err := reqparse.ParseUUID("f81d4fae-7dec-11d0-a765-00a0c91e6bf6")
+	require.NoError(t, err)
+
+	text, err := u.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", string(text))
+
+	var unmarshaled reqparse.UUID
+	require.NoError(t, unmarshaled.UnmarshalText(text))
+	assert.Equal(t, u, unmarshaled)
+
+	assert.False(t, u.IsZero())
+	assert.True(t, reqparse.UUID{}.IsZero())
+}
+
+func TestParseQueryUUID(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		ID       reqparse.UUID   `query:"id"`
+		IDs      []reqparse.UUID `query:"ids"      unique:"true"`
+		ParentID *reqparse.UUID  `query:"parent_id"`
+		Owner    externalUUID    `query:"owner"    default:"00000000-0000-0000-0000-000000000000"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id": {"f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+			"ids": {
+				"f81d4fae-7dec-11d0-a765-00a0c91e6bf6",
+				"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+			},
+			"owner": {"6ba7b8109dad11d180b400c04fd430c8"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", s.ID.String())
+		require.Len(t, s.IDs, 2)
+		assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", s.IDs[1].String())
+		assert.Nil(t, s.ParentID)
+		assert.Equal(t, externalUUID(s.IDs[1]), s.Owner)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":        {"not-a-uuid"},
+			"ids":       {"f81d4fae-7dec-11d0-a765-00a0c91e6bf6", "f81d4fae"},
+			"parent_id": {"f81d4fae-7dec-11d0-a765"},
+			"owner":     {"zz"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"id":        {"must be a valid UUID"},
+			"ids":       {"(Index: 1) must be a valid UUID"},
+			"parent_id": {"must be a valid UUID"},
+			"owner":     {"must be a valid UUID"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("duplicate values", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":  {"f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+			"ids": {"f81d4fae-7dec-11d0-a765-00a0c91e6bf6", "F81D4FAE7DEC11D0A76500A0C91E6BF6"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"ids": {"values must be unique"},
+		}, validationErr.FieldErrors)
+	})
+}
+
+func TestParseJSONUUID(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		ID reqparse.UUID `json:"id"`
+	}
+
+	var s MyStruct
+	err := reqparse.ParseJSON(strings.NewReader(`{"id": "f81d4fae"}`), &s, nil)
+
+	var validationErr *reqparse.QueryValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, map[string][]string{
+		"id": {"must be a valid UUID"},
+	}, validationErr.FieldErrors)
+}
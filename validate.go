@@ -0,0 +1,213 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// validateConstraint creates the rule of a constraint of the `validate` tag for the argument after
+// "=", e.g. "asc desc" for "oneof=asc desc". valueType is the type of the casted values of the
+// field, see [fieldValueType]. ok is false if the argument is invalid or the constraint can't be
+// used with the value type.
+type validateConstraint func(arg string, valueType reflect.Type) (rule Rule, ok bool)
+
+// validateConstraints are the constraints of the `validate` tag, keyed by their names. The
+// "required" and "pattern" constraints are parsed by [validateRules].
+var validateConstraints = map[string]validateConstraint{ //nolint:gochecknoglobals
+	"oneof": func(arg string, _ reflect.Type) (Rule, bool) {
+		allowed := strings.Fields(arg)
+		if len(allowed) == 0 {
+			return nil, false
+		}
+
+		return OneOf(allowed...), true
+	},
+	"min": func(arg string, valueType reflect.Type) (Rule, bool) {
+		return boundConstraint(arg, valueType, Min, MinLength)
+	},
+	"max": func(arg string, valueType reflect.Type) (Rule, bool) {
+		return boundConstraint(arg, valueType, Max, MaxLength)
+	},
+	"len": func(arg string, valueType reflect.Type) (Rule, bool) {
+		n, err := strconv.Atoi(arg)
+		if err != nil || n < 0 || valueType.Kind() != reflect.String {
+			return nil, false
+		}
+
+		return Length(n), true
+	},
+	"multipleof": multipleOfConstraint,
+	"after":      timeBoundConstraint(true),
+	"before":     timeBoundConstraint(false),
+}
+
+// boundConstraint creates the rule of the "min" or "max" constraint: numberRule for numeric values
+// and lengthRule for string values, whose lengths are bounded.
+func boundConstraint(
+	arg string,
+	valueType reflect.Type,
+	numberRule func(n float64) Rule,
+	lengthRule func(n int) Rule,
+) (Rule, bool) {
+	switch valueType.Kind() { //nolint:exhaustive
+	case reflect.String:
+		n, err := strconv.Atoi(arg)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+
+		return lengthRule(n), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float64:
+		n, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, false
+		}
+
+		return numberRule(n), true
+	default:
+		return nil, false
+	}
+}
+
+// validateRules returns the rules of the `validate` tag of the field, e.g.
+// `validate:"required,min=1,max=100"`. Constraints are separated by commas and their rules are
+// applied in order like the other rules: to each element of slice fields and to the pointed value
+// of pointer fields. The "required" constraint has no rule, see [isRequiredField], and the
+// cross-field constraints are checked later, see [crossFieldConstraints]. Unknown constraints and
+// invalid arguments cause [ErrInvalidTag] error.
+func validateRules(structField reflect.StructField, converters []Converter) ([]Rule, error) {
+	tag, ok := structField.Tag.Lookup("validate")
+	if !ok {
+		return nil, nil
+	}
+
+	invalidTagErr := fmt.Errorf("%w: validate:%q (%s)", ErrInvalidTag, tag, structField.Name)
+	valueType := fieldValueType(structField.Type, converters)
+
+	var rules []Rule
+
+	for _, constraint := range splitValidateTag(tag) {
+		name, arg, _ := strings.Cut(constraint, "=")
+
+		switch name {
+		case "required":
+			if constraint != "required" {
+				return nil, invalidTagErr
+			}
+		case "unique":
+			// Duplicate values are checked before the rules, see [uniqueModeFromTags].
+			if constraint != "unique" && arg != string(uniqueDedupe) {
+				return nil, invalidTagErr
+			}
+		case "minitems", "maxitems":
+			// The number of the values is checked before the rules, see [itemCountsFromTag].
+		case "gtfield", "gtefield", "ltfield", "ltefield", "required_if", "required_without":
+			// Cross-field constraints are checked after all fields are bound, see
+			// [runCrossFieldRules].
+			if arg == "" {
+				return nil, invalidTagErr
+			}
+		case "pattern":
+			re, err := compilePattern(arg)
+			if err != nil || valueType.Kind() != reflect.String {
+				return nil, invalidTagErr
+			}
+
+			rules = append(rules, Pattern(re))
+		default:
+			newRule, ok := validateConstraints[name]
+			if !ok {
+				return nil, invalidTagErr
+			}
+
+			rule, ok := newRule(arg, valueType)
+			if !ok {
+				return nil, invalidTagErr
+			}
+
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, nil
+}
+
+// itemCountsFromTag returns the minimum and maximum number of the values of the slice field in the
+// "minitems" and "maxitems" constraints of the `validate` tag, e.g.
+// `validate:"minitems=1,maxitems=50"`. They are zero if there are no such constraints. The
+// constraints are allowed only on slice fields, and maxitems must be positive and not less than
+// minitems.
+func itemCountsFromTag(structField reflect.StructField, isSlice bool) (int, int, error) {
+	var minItems, maxItems int
+
+	for _, constraint := range splitValidateTag(structField.Tag.Get("validate")) {
+		name, arg, _ := strings.Cut(constraint, "=")
+		if name != "minitems" && name != "maxitems" {
+			continue
+		}
+
+		n, err := strconv.Atoi(arg)
+		if err != nil || n < 0 || !isSlice || (name == "maxitems" && n == 0) {
+			return 0, 0, fmt.Errorf(
+				"%w: validate:%q (%s)", ErrInvalidTag, constraint, structField.Name,
+			)
+		}
+
+		if name == "minitems" {
+			minItems = n
+		} else {
+			maxItems = n
+		}
+	}
+
+	if maxItems > 0 && minItems > maxItems {
+		return 0, 0, fmt.Errorf(
+			"%w: validate:%q (%s)",
+			ErrInvalidTag, structField.Tag.Get("validate"), structField.Name,
+		)
+	}
+
+	return minItems, maxItems, nil
+}
+
+// splitValidateTag returns the comma separated constraints of the `validate` tag. Spaces around the
+// constraints are ignored, e.g. "min=1, max=10". Since regular expressions may contain commas, the
+// "pattern" constraint must be the last one and its argument extends to the end of the tag.
+func splitValidateTag(tag string) []string {
+	var constraints []string
+
+	for rest := strings.TrimLeftFunc(tag, unicode.IsSpace); rest != ""; {
+		if strings.HasPrefix(rest, "pattern=") {
+			return append(constraints, rest)
+		}
+
+		var constraint string
+
+		constraint, rest, _ = strings.Cut(rest, ",")
+		constraints = append(constraints, strings.TrimSpace(constraint))
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	}
+
+	return constraints
+}
+
+// isRequiredField reports whether the field has `required:"true"` tag or the "required"
+// constraint in the `validate` tag.
+func isRequiredField(structField reflect.StructField) bool {
+	if structField.Tag.Get("required") == "true" {
+		return true
+	}
+
+	for _, constraint := range splitValidateTag(structField.Tag.Get("validate")) {
+		if constraint == "required" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,394 @@
+package reqparse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryValidateOneOf(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Order    string   `query:"order"     validate:"oneof=asc desc"     default:"asc"`
+		Statuses []string `query:"status"    validate:"oneof=active draft"`
+		PageSize *int     `query:"page_size" validate:"oneof=10 20 50"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"status":    {"active", "draft"},
+			"page_size": {"20"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		pageSize := 20
+		assert.Equal(t, MyStruct{
+			Order:    "asc",
+			Statuses: []string{"active", "draft"},
+			PageSize: &pageSize,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"order":     {"up"},
+			"status":    {"active", "deleted"},
+			"page_size": {"25"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"order":     {"must be one of: asc, desc"},
+			"status":    {"(Index: 1) must be one of: active, draft"},
+			"page_size": {"must be one of: 10, 20, 50"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("with presets", func(t *testing.T) {
+		t.Parallel()
+
+		type PresetStruct struct {
+			PageSize int `query:"page_size" validate:"oneof=10 20 500" preset:"page_size"`
+		}
+
+		var s PresetStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"page_size": {"500"}},
+			&s,
+			&reqparse.ParseQueryOptions{
+				Presets: map[string][]reqparse.Rule{"page_size": {reqparse.Max(100)}},
+			},
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page_size": {"must be less than or equal to 100"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid validate tag", func(t *testing.T) {
+		t.Parallel()
+
+		type EmptyOneOf struct {
+			Order string `query:"order" validate:"oneof="`
+		}
+
+		err := reqparse.ParseQuery(map[string][]string{}, &EmptyOneOf{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+
+		type UnknownConstraint struct {
+			Order string `query:"order" validate:"enum=asc desc"`
+		}
+
+		err = reqparse.ParseQuery(map[string][]string{}, &UnknownConstraint{}, nil)
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
+
+func TestParseQueryValidateTag(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		Page     int               `query:"page"     validate:"min=1"             default:"1"`
+		PageSize *int              `query:"per_page" validate:"min=1,max=100"`
+		Price    float64           `query:"price"    validate:"min=0.5,max=9.5"   default:"1"`
+		Query    string            `query:"q"        validate:"required,min=2,max=5"`
+		Code     string            `query:"code"     validate:"len=3"             default:"abc"`
+		Slugs    []string          `query:"slug"     validate:"max=4,pattern=^[a-z0-9,-]+$"`
+		Meta     map[string]string `query:"meta"     validate:"required"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"per_page":    {"100"},
+			"q":           {"çay"},
+			"slug":        {"a-b", "c,d"},
+			"meta[color]": {"red"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		perPage := 100
+		assert.Equal(t, MyStruct{
+			Page:     1,
+			PageSize: &perPage,
+			Price:    1,
+			Query:    "çay",
+			Code:     "abc",
+			Slugs:    []string{"a-b", "c,d"},
+			Meta:     map[string]string{"color": "red"},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"page":     {"0"},
+			"per_page": {"101"},
+			"price":    {"10"},
+			"q":        {"x"},
+			"code":     {"abcd"},
+			"slug":     {"A", "abcde"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page":     {"must be greater than or equal to 1"},
+			"per_page": {"must be less than or equal to 100"},
+			"price":    {"must be less than or equal to 9.5"},
+			"q":        {"must be at least 2 characters long"},
+			"code":     {"must be exactly 3 characters long"},
+			"slug": {
+				"(Index: 0) does not match required pattern",
+				"(Index: 1) must be at most 4 characters long",
+			},
+			"meta": {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("required field", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"meta[a]": {"b"}}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"q": {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("spaces between constraints", func(t *testing.T) {
+		t.Parallel()
+
+		type SpacedStruct struct {
+			Page  int      `query:"page" validate:" min=1, max=10 "`
+			Slugs []string `query:"slug" validate:"max=4, pattern=^[a-z]+$"`
+		}
+
+		var s SpacedStruct
+		err := reqparse.ParseQuery(map[string][]string{
+			"page": {"11"},
+			"slug": {"ab", "a-b"},
+		}, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page": {"must be less than or equal to 10"},
+			"slug": {"(Index: 1) does not match required pattern"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid validate tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				Page int `query:"page" validate:"min=one"`
+			}{},
+			&struct {
+				Query string `query:"q" validate:"max=-1"`
+			}{},
+			&struct {
+				Page int `query:"page" validate:"len=3"`
+			}{},
+			&struct {
+				Active bool `query:"active" validate:"min=1"`
+			}{},
+			&struct {
+				Page int `query:"page" validate:"pattern=^[0-9]+$"`
+			}{},
+			&struct {
+				Query string `query:"q" validate:"pattern=["`
+			}{},
+			&struct {
+				Query string `query:"q" validate:"required=true"`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
+
+func TestParseQueryFieldValidators(t *testing.T) {
+	t.Parallel()
+
+	type Filter struct {
+		Status string `query:"status" validate:"oneof=active draft"`
+	}
+
+	type MyStruct struct {
+		PageSize int      `query:"page_size" preset:"page_size" default:"20"`
+		Tags     []string `query:"tag"`
+		Filter   *Filter  `query:"filter"`
+	}
+
+	multipleOfTen := func(value any) error {
+		if n, ok := value.(int); ok && n%10 != 0 {
+			return errors.New("must be a multiple of 10")
+		}
+
+		return nil
+	}
+
+	opts := &reqparse.ParseQueryOptions{
+		Presets: map[string][]reqparse.Rule{"page_size": {reqparse.Max(100)}},
+		FieldValidators: map[string][]reqparse.Rule{
+			"page_size":     {multipleOfTen},
+			"tag":           {reqparse.MaxLength(3)},
+			"filter.status": {reqparse.OneOf("active")},
+		},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"tag": {"go", "api"}}, &s, opts)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{PageSize: 20, Tags: []string{"go", "api"}}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"page_size":     {"25"},
+			"tag":           {"go", "http"},
+			"filter.status": {"draft"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, opts)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page_size":     {"must be a multiple of 10"},
+			"tag":           {"(Index: 1) must be at most 3 characters long"},
+			"filter.status": {"must be one of: active"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("after presets", func(t *testing.T) {
+		t.Parallel()
+
+		var s MyStruct
+		err := reqparse.ParseQuery(map[string][]string{"page_size": {"105"}}, &s, opts)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page_size": {"must be less than or equal to 100", "must be a multiple of 10"},
+		}, validationErr.FieldErrors)
+	})
+}
+
+func TestParseQueryValidateItemCounts(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		IDs    []int     `query:"id"     validate:"minitems=1,maxitems=3"`
+		Tags   *[]string `query:"tag"    validate:"maxitems=2,max=5"`
+		Fields []string  `query:"fields" validate:"minitems=2" default:"id,name"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id": {"1", "2", "3"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{
+			IDs:    []int{1, 2, 3},
+			Fields: []string{"id", "name"},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"tag":    {"a", "b", "c"},
+			"fields": {"id"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"id":     {"must have at least 1 value"},
+			"tag":    {"must have at most 2 values"},
+			"fields": {"must have at least 2 values"},
+		}, validationErr.FieldErrors)
+
+		err = reqparse.ParseQuery(map[string][]string{"id": {"1", "2", "3", "x"}}, &s, nil)
+
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"id": {"(Index: 3) must be a valid integer"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("invalid validate tag", func(t *testing.T) {
+		t.Parallel()
+
+		invalidStructs := []any{
+			&struct {
+				ID int `query:"id" validate:"minitems=1"`
+			}{},
+			&struct {
+				IDs []int `query:"id" validate:"maxitems=0"`
+			}{},
+			&struct {
+				IDs []int `query:"id" validate:"minitems=3,maxitems=2"`
+			}{},
+			&struct {
+				IDs []int `query:"id" validate:"minitems=x"`
+			}{},
+		}
+
+		for _, target := range invalidStructs {
+			err := reqparse.ParseQuery(map[string][]string{}, target, nil)
+			require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+		}
+	})
+}
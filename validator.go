@@ -0,0 +1,179 @@
+package reqparse
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// StructValidator validates the populated target struct, see [ParseQueryOptions.Validator]. It is
+// satisfied by *validator.Validate of github.com/go-playground/validator.
+type StructValidator interface {
+	Struct(s any) error
+}
+
+// validatorFieldError is the error of a single field returned by a [StructValidator], e.g.
+// validator.FieldError of github.com/go-playground/validator.
+type validatorFieldError interface {
+	// StructNamespace returns the path of the Go field names of the field prefixed with the name
+	// of the struct type, e.g. "Filter.Range.Min".
+	StructNamespace() string
+
+	// Tag returns the name of the failed validation, e.g. "min".
+	Tag() string
+
+	// Param returns the parameter of the failed validation, e.g. "1" for "min=1".
+	Param() string
+}
+
+// fieldKeyFunc returns the key of the field at the path of the Go field names in the source, e.g.
+// "filter.status" for []string{"Filter", "Status"}. Names of the slice fields may be followed by
+// the index of the element, e.g. "Items[0]". It returns false if the field isn't bound from the
+// source.
+type fieldKeyFunc func(fieldPath []string) (string, bool)
+
+// runValidator runs [ParseQueryOptions.Validator] on the populated struct and adds its errors to
+// the validation errors. Errors of the fields are reported with the keys of the fields returned by
+// fieldKey, and are skipped if the field already has an error, e.g. a casting error. The other
+// errors are reported as struct errors.
+func runValidator(
+	structElem reflect.Value,
+	fieldKey fieldKeyFunc,
+	validationErrors *QueryValidationError,
+	validator StructValidator,
+) {
+	err := validator.Struct(structElem.Addr().Interface())
+	if err == nil {
+		return
+	}
+
+	errv := reflect.ValueOf(err)
+	if errv.Kind() != reflect.Slice {
+		validationErrors.addStructError(err.Error())
+		return
+	}
+
+	for i := 0; i < errv.Len(); i++ {
+		elem := errv.Index(i).Interface()
+
+		fieldErr, ok := elem.(validatorFieldError)
+		if !ok {
+			validationErrors.addStructError(fmt.Sprint(elem))
+			continue
+		}
+
+		message := validatorMessage(fieldErr)
+
+		key, ok := fieldKey(validatorFieldPath(fieldErr.StructNamespace()))
+		if !ok {
+			validationErrors.addStructError(fieldErr.StructNamespace() + " " + message)
+			continue
+		}
+
+		if _, hasErrors := validationErrors.FieldErrors[key]; hasErrors {
+			continue
+		}
+
+		validationErrors.addFieldErr(key, noIndex, newCodedError(
+			ErrorCode(fieldErr.Tag()), message, map[string]any{"param": fieldErr.Param()},
+		))
+	}
+}
+
+// validatorMessage returns the validation error message of the field error, e.g.
+// "failed on the 'min=1' validation".
+func validatorMessage(fieldErr validatorFieldError) string {
+	tag := fieldErr.Tag()
+	if param := fieldErr.Param(); param != "" {
+		tag += "=" + param
+	}
+
+	return "failed on the '" + tag + "' validation"
+}
+
+// validatorFieldPath returns the Go field names in the struct namespace without the name of the
+// struct type, e.g. []string{"Items[0]", "Name"} for "MyStruct.Items[0].Name".
+func validatorFieldPath(namespace string) []string {
+	_, fieldPath, _ := strings.Cut(namespace, ".")
+
+	return strings.Split(fieldPath, ".")
+}
+
+// fieldKey returns the key of the field at the path of the Go field names. See [fieldKeyFunc].
+func (p *structPlan) fieldKey(fieldPath []string) (string, bool) {
+	if len(fieldPath) == 0 {
+		return "", false
+	}
+
+	for i := range p.fields {
+		field := &p.fields[i]
+		if name, _, _ := strings.Cut(fieldPath[0], "["); field.structField.Name != name {
+			continue
+		}
+
+		if len(fieldPath) == 1 {
+			return field.key, !field.embedded
+		}
+
+		if field.nested == nil {
+			return "", false
+		}
+
+		return field.nested.fieldKey(fieldPath[1:])
+	}
+
+	return "", false
+}
+
+// taggedFieldKey returns the [fieldKeyFunc] of the fields of the struct type whose keys are
+// returned by keyOf. keyOf returns false for the fields that aren't bound, and an empty key for the
+// fields whose fields are bound as if they are declared in the containing struct, e.g. inlined
+// structs. Keys of the fields of nested structs and the indexes of the slice elements are joined
+// with dots, e.g. "filter.status" and "items.0.name".
+func taggedFieldKey(
+	structType reflect.Type,
+	keyOf func(structField reflect.StructField) (string, bool),
+) fieldKeyFunc {
+	return func(fieldPath []string) (string, bool) {
+		fieldType := structType
+		keys := make([]string, 0, len(fieldPath))
+
+		for _, segment := range fieldPath {
+			name, index, hasIndex := strings.Cut(segment, "[")
+
+			for fieldType.Kind() == reflect.Pointer || fieldType.Kind() == reflect.Slice {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() != reflect.Struct {
+				return "", false
+			}
+
+			structField, ok := fieldType.FieldByName(name)
+			if !ok {
+				return "", false
+			}
+
+			key, ok := keyOf(structField)
+			if !ok {
+				return "", false
+			}
+
+			if key != "" {
+				keys = append(keys, key)
+			}
+
+			if hasIndex {
+				keys = append(keys, strings.TrimSuffix(index, "]"))
+			}
+
+			fieldType = structField.Type
+		}
+
+		if len(keys) == 0 {
+			return "", false
+		}
+
+		return strings.Join(keys, "."), true
+	}
+}
@@ -0,0 +1,204 @@
+package reqparse_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeFieldError mimics validator.FieldError of github.com/go-playground/validator.
+type fakeFieldError struct {
+	namespace string
+	tag       string
+	param     string
+}
+
+func (e fakeFieldError) StructNamespace() string { return e.namespace }
+func (e fakeFieldError) Tag() string             { return e.tag }
+func (e fakeFieldError) Param() string           { return e.param }
+func (e fakeFieldError) Error() string           { return e.namespace + " failed on " + e.tag }
+
+// fakeValidationErrors mimics validator.ValidationErrors of github.com/go-playground/validator.
+type fakeValidationErrors []fakeFieldError
+
+func (e fakeValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldErr := range e {
+		messages = append(messages, fieldErr.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// fakeValidator records the validated structs and returns the given error.
+type fakeValidator struct {
+	err       error
+	validated []any
+}
+
+func (v *fakeValidator) Struct(s any) error {
+	v.validated = append(v.validated, s)
+	return v.err
+}
+
+func TestParseQueryValidator(t *testing.T) {
+	t.Parallel()
+
+	type Filter struct {
+		Status *string `query:"status"`
+	}
+
+	type Base struct {
+		Lang *string `query:"lang"`
+	}
+
+	type MyStruct struct {
+		Base
+		Page   int    `query:"page"  validate:"gte=1"`
+		Email  string `query:"email" validate:"required,email"`
+		Filter Filter `query:"filter"`
+		Secret string `query:"-"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		validator := &fakeValidator{}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"page": {"2"}, "email": {"a@b.c"}},
+			&s,
+			&reqparse.ParseQueryOptions{Validator: validator},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, MyStruct{Page: 2, Email: "a@b.c"}, s)
+		assert.Equal(t, []any{&s}, validator.validated)
+	})
+
+	t.Run("field and struct errors", func(t *testing.T) {
+		t.Parallel()
+
+		validator := &fakeValidator{err: fakeValidationErrors{
+			{namespace: "MyStruct.Page", tag: "gte", param: "1"},
+			{namespace: "MyStruct.Email", tag: "email"},
+			{namespace: "MyStruct.Filter.Status", tag: "oneof", param: "active draft"},
+			{namespace: "MyStruct.Base.Lang", tag: "len", param: "2"},
+			{namespace: "MyStruct.Secret", tag: "required"},
+		}}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"page": {"0"}, "email": {"x"}},
+			&s,
+			&reqparse.ParseQueryOptions{Validator: validator},
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page":          {"failed on the 'gte=1' validation"},
+			"email":         {"failed on the 'email' validation"},
+			"filter.status": {"failed on the 'oneof=active draft' validation"},
+			"lang":          {"failed on the 'len=2' validation"},
+		}, validationErr.FieldErrors)
+		assert.Equal(t, []string{
+			"MyStruct.Secret failed on the 'required' validation",
+		}, validationErr.StructErrors)
+	})
+
+	t.Run("fields with errors", func(t *testing.T) {
+		t.Parallel()
+
+		validator := &fakeValidator{err: fakeValidationErrors{
+			{namespace: "MyStruct.Page", tag: "gte", param: "1"},
+			{namespace: "MyStruct.Email", tag: "required"},
+		}}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"page": {"x"}},
+			&s,
+			&reqparse.ParseQueryOptions{Validator: validator},
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"page":  {"must be a valid integer"},
+			"email": {"field is required"},
+		}, validationErr.FieldErrors)
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		t.Parallel()
+
+		validator := &fakeValidator{err: errors.New("validator: (nil *MyStruct)")}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(
+			map[string][]string{"page": {"1"}, "email": {"a@b.c"}},
+			&s,
+			&reqparse.ParseQueryOptions{Validator: validator},
+		)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{"validator: (nil *MyStruct)"}, validationErr.StructErrors)
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		t.Parallel()
+
+		validator := &fakeValidator{err: fakeValidationErrors{
+			{namespace: "MyStruct.Page", tag: "gte", param: "1"},
+		}}
+
+		s := MyStruct{Page: 5}
+		err := reqparse.ParseQuery(
+			map[string][]string{"page": {"0"}, "email": {"a@b.c"}},
+			&s,
+			&reqparse.ParseQueryOptions{Validator: validator, Atomic: true},
+		)
+		require.Error(t, err)
+
+		assert.Equal(t, MyStruct{Page: 5}, s)
+	})
+}
+
+func TestParseJSONValidator(t *testing.T) {
+	t.Parallel()
+
+	type Item struct {
+		Name string `json:"name"`
+	}
+
+	type MyStruct struct {
+		Title string `json:"title"`
+		Items []Item `json:"items"`
+	}
+
+	validator := &fakeValidator{err: fakeValidationErrors{
+		{namespace: "MyStruct.Title", tag: "max", param: "3"},
+		{namespace: "MyStruct.Items[1].Name", tag: "required"},
+	}}
+
+	var s MyStruct
+	err := reqparse.ParseJSON(
+		strings.NewReader(`{"title": "long", "items": [{"name": "a"}, {}]}`),
+		&s,
+		&reqparse.ParseQueryOptions{Validator: validator},
+	)
+
+	var validationErr *reqparse.QueryValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, map[string][]string{
+		"title":        {"failed on the 'max=3' validation"},
+		"items.1.name": {"failed on the 'required' validation"},
+	}, validationErr.FieldErrors)
+}
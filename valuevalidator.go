@@ -0,0 +1,46 @@
+package reqparse
+
+import "reflect"
+
+// QueryValueValidator is implemented by the field types that validate their own values, e.g. ID
+// types with a checksum. Fields of these types (and slices and pointers of them) are validated by
+// calling ValidateQueryValue on each casted value, and the message of the returned error is
+// reported as a validation error of the field.
+type QueryValueValidator interface {
+	ValidateQueryValue() error
+}
+
+//nolint:gochecknoglobals
+var queryValueValidatorType = reflect.TypeOf((*QueryValueValidator)(nil)).Elem()
+
+// valueValidatorRule returns the rule calling ValidateQueryValue on the values of the field type.
+// ok is false if the value type doesn't implement [QueryValueValidator] with a value or pointer
+// receiver.
+func valueValidatorRule(fieldType reflect.Type, converters []Converter) (Rule, bool) {
+	valueType := fieldValueType(fieldType, converters)
+
+	if !valueType.Implements(queryValueValidatorType) &&
+		!reflect.PointerTo(valueType).Implements(queryValueValidatorType) {
+		return nil, false
+	}
+
+	return func(value any) error {
+		if validator, ok := value.(QueryValueValidator); ok {
+			return validator.ValidateQueryValue()
+		}
+
+		v := reflect.ValueOf(value)
+		if v.Type() != valueType {
+			return nil
+		}
+
+		// Values passed to the rules are not addressable, so methods with pointer receivers are
+		// called on a copy.
+		pointer := reflect.New(valueType)
+		pointer.Elem().Set(v)
+
+		validator, _ := pointer.Interface().(QueryValueValidator)
+
+		return validator.ValidateQueryValue()
+	}, true
+}
@@ -0,0 +1,88 @@
+package reqparse_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderID string
+
+func (id orderID) ValidateQueryValue() error {
+	if !strings.HasPrefix(string(id), "ord_") {
+		return errors.New(`must start with "ord_"`)
+	}
+
+	return nil
+}
+
+type evenNumber int
+
+func (n *evenNumber) ValidateQueryValue() error {
+	if *n%2 != 0 {
+		return errors.New("must be an even number")
+	}
+
+	return nil
+}
+
+func TestParseQueryValueValidator(t *testing.T) {
+	t.Parallel()
+
+	type MyStruct struct {
+		ID      orderID      `query:"id"`
+		Parents []orderID    `query:"parent"`
+		Count   *evenNumber  `query:"count"  validate:"max=10"`
+		Sizes   []evenNumber `query:"size"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":     {"ord_1"},
+			"parent": {"ord_2", "ord_3"},
+			"count":  {"4"},
+			"size":   {"2", "8"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+		require.NoError(t, err)
+
+		count := evenNumber(4)
+		assert.Equal(t, MyStruct{
+			ID:      "ord_1",
+			Parents: []orderID{"ord_2", "ord_3"},
+			Count:   &count,
+			Sizes:   []evenNumber{2, 8},
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		inputQueryParams := map[string][]string{
+			"id":     {"1"},
+			"parent": {"ord_2", "3"},
+			"count":  {"13"},
+			"size":   {"x", "3"},
+		}
+
+		var s MyStruct
+		err := reqparse.ParseQuery(inputQueryParams, &s, nil)
+
+		var validationErr *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, map[string][]string{
+			"id":     {`must start with "ord_"`},
+			"parent": {`(Index: 1) must start with "ord_"`},
+			"count":  {"must be an even number", "must be less than or equal to 10"},
+			"size":   {"(Index: 0) must be a valid integer"},
+		}, validationErr.FieldErrors)
+	})
+}
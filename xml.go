@@ -0,0 +1,247 @@
+package reqparse
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// xmlSourceDescription is the description of XML bodies used in the validation error messages.
+const xmlSourceDescription = "XML body"
+
+// xmlNameType is the type of the XMLName fields, which are not bound from the XML document.
+var xmlNameType = reflect.TypeOf(xml.Name{}) //nolint:gochecknoglobals
+
+// xmlNode is a generic representation of an XML element.
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Text     string     `xml:",chardata"`
+	Children []xmlNode  `xml:",any"`
+}
+
+// ParseXML decodes the XML document read from r into given struct. Fields are bound from the child
+// elements of the root element, or from its attributes if the field has the `attr` flag. Element
+// and attribute names are specified by the `xml` tag the same way as [encoding/xml]: the name in
+// the tag, or the field name if the tag has no name. Fields tagged with `xml:"-"`, XMLName fields
+// and unexported fields are ignored.
+//
+// Fields with the types supported by [ParseQuery] are bound from the text of the elements and
+// work the same way as query parameters: repeated elements are bound to slice fields, and default
+// values, required fields and the other tags are supported. Struct, pointer to struct and slice of
+// struct fields are bound from the nested elements recursively; they are optional unless they have
+// `required:"true"` tag. Errors of nested fields are reported with dotted keys, e.g. "address.zip"
+// or "item.0.price".
+//
+// If the body is not a valid XML document, a [QueryValidationError] with a struct error is
+// returned. Errors reading from r are returned as is. If options are nil, default options are
+// used.
+func ParseXML(r io.Reader, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseXML(r, target)
+}
+
+// parseXML is the implementation of [Parser.ParseXML]. opts must be non-nil.
+func parseXML(r io.Reader, target any, opts *ParseQueryOptions) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryTarget
+	}
+
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	validationErrors := newQueryValidationError(SourceBody, xmlSourceDescription, opts)
+
+	var root xmlNode
+	if err := xml.Unmarshal(body, &root); err != nil {
+		validationErrors.addStructError("request body must be valid XML")
+
+		return validationErrors.err()
+	}
+
+	structElem := v.Elem()
+	if opts.Atomic {
+		structElem = reflect.New(structElem.Type()).Elem()
+	}
+
+	boundFieldIndexes, err := bindXMLStruct(structElem, root, "", opts, validationErrors)
+	if err != nil {
+		return err
+	}
+
+	return completeBinding(
+		target, structElem, len(boundFieldIndexes) > 0, boundFieldIndexes, validationErrors, opts,
+		taggedFieldKey(structElem.Type(), xmlFieldKey),
+	)
+}
+
+// bindXMLStruct binds the attributes and the child elements of the node into the struct. keyPrefix
+// is prepended to the keys of the field errors. It returns the indexes of the bound fields.
+func bindXMLStruct( //nolint:cyclop,funlen
+	structv reflect.Value,
+	node xmlNode,
+	keyPrefix string,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) ([]int, error) {
+	elementValues := make(map[string][]string, len(node.Children))
+	elements := make(map[string][]xmlNode, len(node.Children))
+
+	for _, child := range node.Children {
+		elementValues[child.XMLName.Local] = append(elementValues[child.XMLName.Local], child.Text)
+		elements[child.XMLName.Local] = append(elements[child.XMLName.Local], child)
+	}
+
+	attrValues := make(map[string][]string, len(node.Attrs))
+	for _, attr := range node.Attrs { //nolint:wsl
+		attrValues[attr.Name.Local] = append(attrValues[attr.Name.Local], attr.Value)
+	}
+
+	boundFieldIndexes := make([]int, 0, structv.NumField())
+
+	for i := 0; i < structv.NumField(); i++ {
+		fieldv := structv.Field(i)
+		structField := structv.Type().Field(i)
+
+		tag := structField.Tag.Get("xml")
+		if !structField.IsExported() || structField.Type == xmlNameType || tag == "-" {
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		if strings.Contains(name, ">") {
+			return nil, fmt.Errorf("%w: xml:%q (%s)", ErrInvalidTag, tag, structField.Name)
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+
+		fieldKey := keyPrefix + name
+		isAttr := strings.Contains(","+flags+",", ",attr,")
+
+		values := elementValues[name]
+		if isAttr {
+			values = attrValues[name]
+		}
+
+		switch {
+		case fieldv.Kind() != reflect.Map &&
+			isFieldTypeAllowedForQueryParsing(fieldv.Type(), opts.Converters):
+			sourceValues := map[string][]string{}
+			if len(values) > 0 {
+				sourceValues[fieldKey] = values
+			}
+
+			err := populateStructField(
+				fieldv, structField, fieldKey, sourceValues, opts, validationErrors,
+			)
+			if err != nil {
+				return nil, err
+			}
+		case !isAttr && isStructFieldType(fieldv.Type(), opts.Converters):
+			err := bindXMLStructField(
+				fieldv, structField, fieldKey, elements[name], opts, validationErrors,
+			)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf(
+				"%w: %s (%s)",
+				ErrInvalidQueryFieldType,
+				structField.Name,
+				fieldv.Type(),
+			)
+		}
+
+		boundFieldIndexes = append(boundFieldIndexes, i)
+	}
+
+	return boundFieldIndexes, nil
+}
+
+// xmlFieldKey returns the name of the element or attribute of the struct field, and false if the
+// field isn't bound from the XML document.
+func xmlFieldKey(structField reflect.StructField) (string, bool) {
+	name, _, _ := strings.Cut(structField.Tag.Get("xml"), ",")
+	if !structField.IsExported() || structField.Type == xmlNameType || name == "-" {
+		return "", false
+	}
+
+	if name == "" {
+		return structField.Name, true
+	}
+
+	return name, true
+}
+
+// isStructFieldType reports whether the field type is a struct, pointer to struct or slice of
+// structs whose fields are bound recursively from nested values, e.g. nested XML elements.
+func isStructFieldType(fieldType reflect.Type, converters []Converter) bool {
+	if fieldType.Kind() == reflect.Pointer || fieldType.Kind() == reflect.Slice {
+		fieldType = fieldType.Elem()
+	}
+
+	return fieldType.Kind() == reflect.Struct &&
+		!isValueTypeAllowedForQueryParsing(fieldType, converters)
+}
+
+// bindXMLStructField binds the nested elements into the struct, pointer to struct or slice of
+// structs field.
+func bindXMLStructField(
+	fieldv reflect.Value,
+	structField reflect.StructField,
+	fieldKey string,
+	elements []xmlNode,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) error {
+	if len(elements) == 0 {
+		if structField.Tag.Get("required") == "true" {
+			validationErrors.addFieldErr(fieldKey, noIndex, errRequired)
+		} else if fieldv.Kind() == reflect.Slice {
+			fieldv.Set(reflect.MakeSlice(fieldv.Type(), 0, 0))
+		}
+
+		return nil
+	}
+
+	switch fieldv.Kind() { //nolint:exhaustive
+	case reflect.Slice:
+		slice := reflect.MakeSlice(fieldv.Type(), len(elements), len(elements))
+		for i, element := range elements {
+			elementKey := fieldKey + "." + strconv.Itoa(i) + "."
+
+			_, err := bindXMLStruct(slice.Index(i), element, elementKey, opts, validationErrors)
+			if err != nil {
+				return err
+			}
+		}
+
+		fieldv.Set(slice)
+	case reflect.Pointer:
+		structPointer := reflect.New(fieldv.Type().Elem())
+
+		_, err := bindXMLStruct(
+			structPointer.Elem(), elements[0], fieldKey+".", opts, validationErrors,
+		)
+		if err != nil {
+			return err
+		}
+
+		fieldv.Set(structPointer)
+	default:
+		_, err := bindXMLStruct(fieldv, elements[0], fieldKey+".", opts, validationErrors)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package reqparse_test
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXML(t *testing.T) {
+	t.Parallel()
+
+	type Address struct {
+		City string `xml:"city"`
+		Zip  int    `xml:"zip"`
+	}
+
+	type Item struct {
+		SKU   string  `xml:"sku,attr"`
+		Price float64 `xml:"price"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			XMLName   xml.Name  `xml:"order"`
+			ID        int       `xml:"id,attr"`
+			Customer  string    `xml:"customer"`
+			Notes     []string  `xml:"note"`
+			Express   *bool     `xml:"express"`
+			CreatedAt time.Time `xml:"created_at"`
+			Currency  string    `xml:"currency"         default:"EUR"`
+			Address   *Address  `xml:"address"`
+			Items     []Item    `xml:"item"`
+			Coupon    *Address  `xml:"coupon"`
+			Ignored   string    `xml:"-"`
+			Status    string
+		}
+
+		body := `<order id="7">
+			<customer>Berk</customer>
+			<note>first</note>
+			<note>second</note>
+			<created_at>2024-01-02T03:04:05Z</created_at>
+			<address><city>Izmir</city><zip>35000</zip></address>
+			<item sku="a-1"><price>9.5</price></item>
+			<item sku="b-2"><price>20</price></item>
+			<Status>paid</Status>
+		</order>`
+
+		var s MyStruct
+		err := reqparse.ParseXML(strings.NewReader(body), &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			ID:        7,
+			Customer:  "Berk",
+			Notes:     []string{"first", "second"},
+			Express:   nil,
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Currency:  "EUR",
+			Address:   &Address{City: "Izmir", Zip: 35000},
+			Items:     []Item{{SKU: "a-1", Price: 9.5}, {SKU: "b-2", Price: 20}},
+			Coupon:    nil,
+			Status:    "paid",
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			ID       int      `xml:"id,attr"`
+			Customer string   `xml:"customer"`
+			Express  bool     `xml:"express"`
+			Address  Address  `xml:"address"`
+			Items    []Item   `xml:"item"`
+			Billing  *Address `xml:"billing"  required:"true"`
+		}
+
+		body := `<order id="x">
+			<express>yes</express>
+			<address><city>Izmir</city><zip>abc</zip></address>
+			<item sku="a-1"><price>9.5</price></item>
+			<item><price>free</price></item>
+		</order>`
+
+		var s MyStruct
+		err := reqparse.ParseXML(strings.NewReader(body), &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"id":           {"must be a valid integer"},
+			"customer":     {"field is required"},
+			"express":      {"must be a valid boolean"},
+			"address.zip":  {"must be a valid integer"},
+			"item.1.sku":   {"field is required"},
+			"item.1.price": {"must be a valid float"},
+			"billing":      {"field is required"},
+		}, validationError.FieldErrors)
+		assert.Contains(t, validationError.Error(), "Parsing XML body failed.")
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Customer string `xml:"customer"`
+		}
+
+		for _, body := range []string{"", "<order><customer>Berk</order>", "not xml"} {
+			var s MyStruct
+			err := reqparse.ParseXML(strings.NewReader(body), &s, nil)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError, body)
+			assert.Equal(
+				t, []string{"request body must be valid XML"}, validationError.StructErrors,
+			)
+			assert.Empty(t, validationError.FieldErrors)
+		}
+	})
+
+	t.Run("unsupported field type", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Meta map[string]string `xml:"meta"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseXML(strings.NewReader("<order/>"), &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+	})
+
+	t.Run("nested paths are not supported", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			City string `xml:"address>city"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseXML(strings.NewReader("<order/>"), &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidTag)
+	})
+}
@@ -0,0 +1,308 @@
+package reqparse
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlSourceDescription is the description of YAML bodies used in the validation error messages.
+const yamlSourceDescription = "YAML body"
+
+// ParseYAML decodes the YAML document read from r into given struct. Mapping keys are specified by
+// the `yaml` tag the same way as [gopkg.in/yaml.v3]: the name in the tag, or the lower cased field
+// name if the tag has no name. Fields tagged with `yaml:"-"` and unexported fields are ignored, and
+// fields with the `inline` flag are bound from the same mapping.
+//
+// Fields with the types supported by [ParseQuery] are bound from scalar values, or sequences of
+// scalar values for slice fields, and work the same way as query parameters: default values,
+// required fields and the other tags are supported. Struct, pointer to struct and slice of struct
+// fields are bound from the nested mappings recursively; they are optional unless they have
+// `required:"true"` tag. Fields of other types (e.g. maps) cause [ErrInvalidQueryFieldType] error.
+// Errors of nested fields are reported with dotted keys, e.g. "server.port" or "users.0.name".
+//
+// If the body is not a valid YAML mapping, a [QueryValidationError] with a struct error is
+// returned. An empty body is treated as an empty mapping. Errors reading from r are returned as
+// is. If options are nil, default options are used.
+func ParseYAML(r io.Reader, target any, opts *ParseQueryOptions) error {
+	return NewParser(opts).ParseYAML(r, target)
+}
+
+// parseYAML is the implementation of [Parser.ParseYAML]. opts must be non-nil.
+func parseYAML(r io.Reader, target any, opts *ParseQueryOptions) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ErrInvalidQueryTarget
+	}
+
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	validationErrors := newQueryValidationError(SourceBody, yamlSourceDescription, opts)
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(body, &document); err != nil {
+		validationErrors.addStructError("request body must be valid YAML")
+
+		return validationErrors.err()
+	}
+
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	if len(document.Content) > 0 {
+		root = resolveYAMLAlias(document.Content[0])
+	}
+
+	if root.Kind != yaml.MappingNode {
+		validationErrors.addStructError("request body must be a YAML mapping")
+
+		return validationErrors.err()
+	}
+
+	structElem := v.Elem()
+	if opts.Atomic {
+		structElem = reflect.New(structElem.Type()).Elem()
+	}
+
+	boundFieldIndexes, err := bindYAMLStruct(structElem, root, "", opts, validationErrors)
+	if err != nil {
+		return err
+	}
+
+	return completeBinding(
+		target, structElem, len(boundFieldIndexes) > 0, boundFieldIndexes, validationErrors, opts,
+		taggedFieldKey(structElem.Type(), yamlFieldKey),
+	)
+}
+
+// bindYAMLStruct binds the values of the mapping node into the struct. keyPrefix is prepended to
+// the keys of the field errors. It returns the indexes of the bound fields.
+func bindYAMLStruct( //nolint:cyclop,funlen
+	structv reflect.Value,
+	mapping *yaml.Node,
+	keyPrefix string,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) ([]int, error) {
+	mappingValues := make(map[string]*yaml.Node, len(mapping.Content)/2)
+	for i := 0; i+1 < len(mapping.Content); i += 2 { //nolint:wsl
+		mappingValues[mapping.Content[i].Value] = resolveYAMLAlias(mapping.Content[i+1])
+	}
+
+	boundFieldIndexes := make([]int, 0, structv.NumField())
+
+	for i := 0; i < structv.NumField(); i++ {
+		fieldv := structv.Field(i)
+		structField := structv.Type().Field(i)
+
+		tag := structField.Tag.Get("yaml")
+		if !structField.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+
+		if strings.Contains(","+flags+",", ",inline,") && fieldv.Kind() == reflect.Struct {
+			_, err := bindYAMLStruct(fieldv, mapping, keyPrefix, opts, validationErrors)
+			if err != nil {
+				return nil, err
+			}
+
+			boundFieldIndexes = append(boundFieldIndexes, i)
+
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(structField.Name)
+		}
+
+		fieldKey := keyPrefix + name
+
+		node, ok := mappingValues[name]
+		if ok && node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+			ok = false
+		}
+
+		var err error
+
+		switch {
+		case fieldv.Kind() != reflect.Map &&
+			isFieldTypeAllowedForQueryParsing(fieldv.Type(), opts.Converters):
+			err = bindYAMLValueField(
+				fieldv, structField, fieldKey, node, ok, opts, validationErrors,
+			)
+		case isStructFieldType(fieldv.Type(), opts.Converters):
+			err = bindYAMLStructField(
+				fieldv, structField, fieldKey, node, ok, opts, validationErrors,
+			)
+		default:
+			return nil, fmt.Errorf(
+				"%w: %s (%s)",
+				ErrInvalidQueryFieldType,
+				structField.Name,
+				fieldv.Type(),
+			)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		boundFieldIndexes = append(boundFieldIndexes, i)
+	}
+
+	return boundFieldIndexes, nil
+}
+
+// bindYAMLValueField binds the scalar value, or the sequence of scalar values for slice fields, of
+// the node into the field the same way as query parameters. ok reports whether the node is present.
+func bindYAMLValueField(
+	fieldv reflect.Value,
+	structField reflect.StructField,
+	fieldKey string,
+	node *yaml.Node,
+	ok bool,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) error {
+	sourceValues := map[string][]string{}
+
+	if ok {
+		values, valid := yamlScalarValues(node, fieldv.Kind() == reflect.Slice)
+		if !valid {
+			validationErrors.addFieldErr(fieldKey, noIndex, typeError(fieldv.Type()))
+			return nil
+		}
+
+		sourceValues[fieldKey] = values
+	}
+
+	return populateStructField(fieldv, structField, fieldKey, sourceValues, opts, validationErrors)
+}
+
+// yamlScalarValues returns the scalar values of the node. Sequences of scalars are allowed only if
+// allowSequence is true. It returns false if the node doesn't contain only scalar values.
+func yamlScalarValues(node *yaml.Node, allowSequence bool) ([]string, bool) {
+	if node.Kind == yaml.ScalarNode {
+		return []string{node.Value}, true
+	}
+
+	if node.Kind != yaml.SequenceNode || !allowSequence {
+		return nil, false
+	}
+
+	values := make([]string, 0, len(node.Content))
+	for _, item := range node.Content { //nolint:wsl
+		item = resolveYAMLAlias(item)
+		if item.Kind != yaml.ScalarNode {
+			return nil, false
+		}
+
+		values = append(values, item.Value)
+	}
+
+	return values, true
+}
+
+// bindYAMLStructField binds the nested mapping, or the sequence of mappings for slice fields, of
+// the node into the struct, pointer to struct or slice of structs field. ok reports whether the
+// node is present.
+func bindYAMLStructField( //nolint:cyclop
+	fieldv reflect.Value,
+	structField reflect.StructField,
+	fieldKey string,
+	node *yaml.Node,
+	ok bool,
+	opts *ParseQueryOptions,
+	validationErrors *QueryValidationError,
+) error {
+	if !ok {
+		if structField.Tag.Get("required") == "true" {
+			validationErrors.addFieldErr(fieldKey, noIndex, errRequired)
+		} else if fieldv.Kind() == reflect.Slice {
+			fieldv.Set(reflect.MakeSlice(fieldv.Type(), 0, 0))
+		}
+
+		return nil
+	}
+
+	if fieldv.Kind() == reflect.Slice {
+		if node.Kind != yaml.SequenceNode {
+			validationErrors.addFieldErr(fieldKey, noIndex, typeError(fieldv.Type()))
+			return nil
+		}
+
+		slice := reflect.MakeSlice(fieldv.Type(), len(node.Content), len(node.Content))
+		for i, item := range node.Content {
+			elementKey := fieldKey + "." + strconv.Itoa(i)
+
+			item = resolveYAMLAlias(item)
+			if item.Kind != yaml.MappingNode {
+				validationErrors.addFieldErr(elementKey, noIndex, typeError(fieldv.Type().Elem()))
+				continue
+			}
+
+			_, err := bindYAMLStruct(slice.Index(i), item, elementKey+".", opts, validationErrors)
+			if err != nil {
+				return err
+			}
+		}
+
+		fieldv.Set(slice)
+
+		return nil
+	}
+
+	if node.Kind != yaml.MappingNode {
+		validationErrors.addFieldErr(fieldKey, noIndex, typeError(fieldv.Type()))
+		return nil
+	}
+
+	structv := fieldv
+	if fieldv.Kind() == reflect.Pointer {
+		structv = reflect.New(fieldv.Type().Elem()).Elem()
+	}
+
+	_, err := bindYAMLStruct(structv, node, fieldKey+".", opts, validationErrors)
+	if err != nil {
+		return err
+	}
+
+	if fieldv.Kind() == reflect.Pointer {
+		fieldv.Set(structv.Addr())
+	}
+
+	return nil
+}
+
+// yamlFieldKey returns the mapping key of the struct field, and false if the field isn't bound
+// from the YAML document. Inlined structs have no key.
+func yamlFieldKey(structField reflect.StructField) (string, bool) {
+	name, flags, _ := strings.Cut(structField.Tag.Get("yaml"), ",")
+
+	switch {
+	case !structField.IsExported() || name == "-":
+		return "", false
+	case strings.Contains(","+flags+",", ",inline,"):
+		return "", true
+	case name == "":
+		return strings.ToLower(structField.Name), true
+	default:
+		return name, true
+	}
+}
+
+// resolveYAMLAlias returns the node the alias node refers to. Other nodes are returned as is.
+func resolveYAMLAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+
+	return node
+}
@@ -0,0 +1,182 @@
+package reqparse_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berk-karaal/reqparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseYAML(t *testing.T) {
+	t.Parallel()
+
+	type Server struct {
+		Host string `yaml:"host"`
+		Port int    `yaml:"port"`
+	}
+
+	type User struct {
+		Name  string `yaml:"name"`
+		Admin bool   `yaml:"admin" default:"false"`
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		type Metadata struct {
+			Owner string `yaml:"owner"`
+		}
+
+		type MyStruct struct {
+			Metadata `yaml:",inline"`
+			Name     string        `yaml:"name"`
+			Replicas *int          `yaml:"replicas"`
+			Timeout  time.Duration `yaml:"timeout"`
+			Regions  []string      `yaml:"regions"`
+			Server   Server        `yaml:"server"`
+			Backup   *Server       `yaml:"backup"`
+			Users    []User        `yaml:"users"`
+			Env      string        `yaml:"env"      default:"production"`
+			Debug    bool
+			Ignored  string `yaml:"-"`
+		}
+
+		body := `
+owner: platform
+name: api
+replicas: 3
+timeout: 30s
+regions: [eu-west-1, us-east-1]
+server: &server
+  host: localhost
+  port: 8080
+backup: *server
+users:
+  - name: berk
+    admin: true
+  - name: guest
+debug: true
+`
+
+		var s MyStruct
+		err := reqparse.ParseYAML(strings.NewReader(body), &s, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, MyStruct{
+			Metadata: Metadata{Owner: "platform"},
+			Name:     "api",
+			Replicas: newPointer(3),
+			Timeout:  30 * time.Second,
+			Regions:  []string{"eu-west-1", "us-east-1"},
+			Server:   Server{Host: "localhost", Port: 8080},
+			Backup:   &Server{Host: "localhost", Port: 8080},
+			Users:    []User{{Name: "berk", Admin: true}, {Name: "guest", Admin: false}},
+			Env:      "production",
+			Debug:    true,
+		}, s)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name     string   `yaml:"name"`
+			Replicas int      `yaml:"replicas"`
+			Regions  []string `yaml:"regions"`
+			Port     int      `yaml:"port"`
+			Server   Server   `yaml:"server"`
+			Users    []User   `yaml:"users"`
+			Backup   *Server  `yaml:"backup"   required:"true"`
+		}
+
+		body := `
+name: ~
+replicas: many
+regions: [a, [b]]
+port: [80, 443]
+server:
+  host: localhost
+  port: http
+users:
+  - name: berk
+  - guest
+`
+
+		var s MyStruct
+		err := reqparse.ParseYAML(strings.NewReader(body), &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name":        {"field is required"},
+			"replicas":    {"must be a valid integer"},
+			"regions":     {"must be a valid array"},
+			"port":        {"must be a valid integer"},
+			"server.port": {"must be a valid integer"},
+			"users.1":     {"must be a valid object"},
+			"backup":      {"field is required"},
+		}, validationError.FieldErrors)
+		assert.Contains(t, validationError.Error(), "Parsing YAML body failed.")
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name string `yaml:"name"`
+		}
+
+		testCases := []struct {
+			body        string
+			structError string
+		}{
+			{body: "name: [a", structError: "request body must be valid YAML"},
+			{body: "- a\n- b", structError: "request body must be a YAML mapping"},
+			{body: "just a string", structError: "request body must be a YAML mapping"},
+		}
+
+		for _, tc := range testCases {
+			var s MyStruct
+			err := reqparse.ParseYAML(strings.NewReader(tc.body), &s, nil)
+
+			var validationError *reqparse.QueryValidationError
+			require.ErrorAs(t, err, &validationError, tc.body)
+			assert.Equal(t, []string{tc.structError}, validationError.StructErrors, tc.body)
+			assert.Empty(t, validationError.FieldErrors)
+		}
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Name string `yaml:"name"`
+			Port int    `yaml:"port" default:"80"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseYAML(strings.NewReader(""), &s, nil)
+
+		var validationError *reqparse.QueryValidationError
+		require.ErrorAs(t, err, &validationError)
+		assert.Equal(t, map[string][]string{
+			"name": {"field is required"},
+		}, validationError.FieldErrors)
+	})
+
+	t.Run("unsupported field type", func(t *testing.T) {
+		t.Parallel()
+
+		type MyStruct struct {
+			Labels map[string]string `yaml:"labels"`
+		}
+
+		var s MyStruct
+		err := reqparse.ParseYAML(strings.NewReader("labels:\n  team: core\n"), &s, nil)
+
+		require.ErrorIs(t, err, reqparse.ErrInvalidQueryFieldType)
+	})
+}